@@ -0,0 +1,205 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// adStateEndingSoon is the state TransitionAds moves a campaign to in its
+	// last AD_EXPIRY_WARNING_DAYS
+	adStateEndingSoon = "ending_soon"
+	// adExtendDays is how long a campaign is extended by when the admin
+	// doesn't say
+	adExtendDays = 30
+)
+
+// adLifecycleDays reads how long before its end a campaign is ending soon
+// and account managers are alerted, AD_EXPIRY_WARNING_DAYS (default 7), and
+// how long an expired ad stays with the inactive ones before it is archived,
+// AD_ARCHIVE_AFTER_DAYS (default 30).
+func adLifecycleDays() (warning, archive int32) {
+	warning, archive = 7, 30
+	if n, err := strconv.Atoi(os.Getenv("AD_EXPIRY_WARNING_DAYS")); err == nil && n > 0 {
+		warning = int32(n)
+	}
+	if n, err := strconv.Atoi(os.Getenv("AD_ARCHIVE_AFTER_DAYS")); err == nil && n > 0 {
+		archive = int32(n)
+	}
+	return warning, archive
+}
+
+// transitionAds moves every ad to the state its dates put it in and alerts
+// account managers of the campaigns that are now ending soon.
+func (server *Server) transitionAds(ctx context.Context) {
+	warning, archive := adLifecycleDays()
+
+	ads, err := server.store.TransitionAds(ctx, db.TransitionAdsParams{
+		ArchiveAfterDays: archive,
+		WarningDays:      warning,
+	})
+	if err != nil {
+		log.Println("Error transitioning ads in transitionAds:", err)
+		return
+	}
+	if len(ads) == 0 {
+		return
+	}
+
+	server.invalidateServableAds(ctx)
+
+	var ending []db.Ad
+	for _, ad := range ads {
+		log.Printf("Ad %v is now %s\n", ad.ID, ad.State)
+		if ad.State == adStateEndingSoon {
+			ending = append(ending, ad)
+		}
+	}
+
+	if len(ending) > 0 {
+		go server.alertEndingAds(ending)
+	}
+}
+
+// adAlertRecipients are the account managers in AD_ALERT_EMAILS, or the
+// admins when it isn't set.
+func (server *Server) adAlertRecipients(ctx context.Context) ([]string, error) {
+	var recipients []string
+	for _, email := range strings.Split(os.Getenv("AD_ALERT_EMAILS"), ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			recipients = append(recipients, email)
+		}
+	}
+	if len(recipients) > 0 {
+		return recipients, nil
+	}
+
+	admins, err := server.store.GetAdminUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, admin := range admins {
+		recipients = append(recipients, admin.Email)
+	}
+
+	return recipients, nil
+}
+
+// alertEndingAds emails the account managers the campaigns that end within
+// AD_EXPIRY_WARNING_DAYS, once as each enters the ending soon state.
+func (server *Server) alertEndingAds(ads []db.Ad) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	recipients, err := server.adAlertRecipients(ctx)
+	if err != nil {
+		log.Println("Error getting alert recipients in alertEndingAds:", err)
+		return
+	}
+
+	campaigns := make([]string, len(ads))
+	for i, ad := range ads {
+		campaigns[i] = fmt.Sprintf("%s (%s), ističe %s", ad.Title.String, ad.Placement.String, ad.EndDate.Time.In(Loc).Format("02.01.2006."))
+	}
+
+	for _, recipient := range recipients {
+		if err := utils.SendAdExpiryEmail(recipient, campaigns, BaseUrl+"/admin/ads"); err != nil {
+			log.Println("Error sending ad expiry email in alertEndingAds:", err)
+		}
+	}
+}
+
+// extendAd moves a campaign's end by the days the admin typed into the
+// prompt, from its current end or from today if it has ended, and switches
+// it back on.
+func (server *Server) extendAd(ctx echo.Context) error {
+	adID, err := utils.ParseUUID(ctx.Param("id"), "ad ID")
+	if err != nil {
+		log.Println("Invalid ad ID format in extendAd:", err)
+		return err
+	}
+
+	days := adExtendDays
+	if prompt := strings.TrimSpace(ctx.Request().Header.Get("HX-Prompt")); prompt != "" {
+		days, err = strconv.Atoi(prompt)
+		if err != nil || days < 1 || days > 365 {
+			return echo.NewHTTPError(http.StatusBadRequest, "Extend by 1 to 365 days")
+		}
+	}
+
+	ad, err := server.store.GetAd(ctx.Request().Context(), adID)
+	if err != nil {
+		log.Println("Error getting ad in extendAd:", err)
+		return err
+	}
+
+	now := time.Now().In(Loc)
+	midnightNow := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, Loc)
+
+	// Ads deactivated by hand have no dates left
+	startDate := midnightNow
+	if ad.StartDate.Valid {
+		startDate = ad.StartDate.Time
+	}
+	endDate := midnightNow
+	if ad.EndDate.Valid && ad.EndDate.Time.After(now) {
+		endDate = ad.EndDate.Time
+	}
+
+	// Same limit as the ad forms
+	endDate = endDate.AddDate(0, 0, days)
+	if endDate.After(midnightNow.AddDate(5, 0, 0)) {
+		return echo.NewHTTPError(http.StatusBadRequest, "End date can't be more than 5 years away")
+	}
+
+	_, err = server.store.ExtendAd(ctx.Request().Context(), db.ExtendAdParams{
+		ID:        adID,
+		StartDate: pgtype.Timestamptz{Time: startDate, Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: endDate, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error extending ad in extendAd:", err)
+		return err
+	}
+
+	server.invalidateServableAds(ctx.Request().Context())
+	server.transitionAds(ctx.Request().Context())
+
+	if startDate.After(now) {
+		return server.scheduledAdsList(ctx)
+	}
+	return server.activeAdsList(ctx)
+}
+
+func (server *Server) listArchivedAds(ctx echo.Context) error {
+	var req ListAdsReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in listArchivedAds:", err)
+		return err
+	}
+
+	nextLimit := req.Limit + 20
+
+	archivedAds, err := server.store.ListArchivedAds(ctx.Request().Context(), nextLimit)
+	if err != nil {
+		log.Println("Error listing archived ads in listArchivedAds:", err)
+		return err
+	}
+
+	url := "/api/admin/ads/archived?limit="
+
+	return Render(ctx, http.StatusOK, components.Ads(int(nextLimit), archivedAds, url))
+}
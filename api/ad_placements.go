@@ -0,0 +1,345 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// The placement registry changes rarely and is read on every page load.
+const (
+	adPlacementsKey = "ads:placements"
+	adPlacementsTTL = 5 * time.Minute
+)
+
+// adImageRatioTolerance is how far an ad image's aspect ratio may be off its
+// placement's
+const adImageRatioTolerance = 0.05
+
+// adPlacements returns the placement registry.
+func (server *Server) adPlacements(ctx context.Context) ([]db.AdPlacement, error) {
+	var placements []db.AdPlacement
+
+	found, err := server.cacheService.Get(ctx, adPlacementsKey, &placements)
+	if err != nil {
+		log.Println("Error getting ad placements from cache in adPlacements:", err)
+	}
+	if found {
+		return placements, nil
+	}
+
+	placements, err = server.store.ListAdPlacements(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.cacheService.Set(ctx, adPlacementsKey, placements, adPlacementsTTL); err != nil {
+		log.Println("Error caching ad placements in adPlacements:", err)
+	}
+
+	return placements, nil
+}
+
+func (server *Server) invalidateAdPlacements(ctx context.Context) {
+	if err := server.cacheService.Delete(ctx, adPlacementsKey); err != nil {
+		log.Println("Error invalidating ad placements in invalidateAdPlacements:", err)
+	}
+}
+
+// articlePathRegexp matches the /:year/:month/:slug article route
+var articlePathRegexp = regexp.MustCompile(`^/\d{4}/\d{1,2}/[^/]+/?$`)
+
+// adPageType returns the kind of page at path, placements are filled only on
+// the page types they list.
+func adPageType(path string) string {
+	switch {
+	case path == "" || path == "/":
+		return "home"
+	case strings.HasPrefix(path, "/kategorije/"):
+		return "category"
+	case strings.HasPrefix(path, "/oznake/"):
+		return "tag"
+	case strings.HasPrefix(path, "/pretraga"):
+		return "search"
+	case articlePathRegexp.MatchString(path):
+		return "article"
+	default:
+		return "other"
+	}
+}
+
+// adImageFits reports whether an image of width x height is at least as big
+// as the placement and has about the same aspect ratio.
+func adImageFits(width, height int, placement db.AdPlacement) bool {
+	if width < int(placement.Width) || height < int(placement.Height) {
+		return false
+	}
+
+	ratio := float64(width) / float64(height)
+	want := float64(placement.Width) / float64(placement.Height)
+
+	return math.Abs(ratio-want)/want <= adImageRatioTolerance
+}
+
+// adCreativeErr checks an uploaded creative against its placement and
+// returns what is wrong with it, or "" if it fits.
+func adCreativeErr(filePath string, placement db.AdPlacement) string {
+	format := utils.AdFormat(filePath)
+	if !slices.Contains(placement.Formats, format) {
+		return fmt.Sprintf("Pozicija %s ne prima ovaj format oglasa.", placement.Name)
+	}
+	if format != utils.AdFormatImage {
+		return ""
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		log.Println("Error opening ad image in adCreativeErr:", err)
+		return "Slika oglasa nije mogla biti pročitana."
+	}
+	defer file.Close()
+
+	config, _, err := image.DecodeConfig(file)
+	if err != nil {
+		log.Println("Error decoding ad image in adCreativeErr:", err)
+		return "Slika oglasa nije mogla biti pročitana."
+	}
+
+	if !adImageFits(config.Width, config.Height, placement) {
+		return fmt.Sprintf(
+			"Slika za poziciju %s mora biti najmanje %dx%d piksela i iste razmere (otpremljena je %dx%d).",
+			placement.Name, placement.Width, placement.Height, config.Width, config.Height,
+		)
+	}
+
+	return ""
+}
+
+// adCreativeSuits reports whether an ad's creative can move to placement
+// as it is. Images were resized for the ad's placement, so they suit one of
+// the same size.
+func (server *Server) adCreativeSuits(ctx echo.Context, ad db.Ad, placement db.AdPlacement) bool {
+	format := utils.AdFormat(ad.ImageUrl.String)
+	if !slices.Contains(placement.Formats, format) {
+		return false
+	}
+	if format != utils.AdFormatImage {
+		return true
+	}
+
+	current, err := server.store.GetAdPlacement(ctx.Request().Context(), ad.Placement.String)
+	if err != nil {
+		log.Println("Error getting ad placement in adCreativeSuits:", err)
+		return false
+	}
+
+	return current.Width == placement.Width && current.Height == placement.Height
+}
+
+// adminAdPlacements lists the placements in the admin's ads page.
+func (server *Server) adminAdPlacements(ctx echo.Context) error {
+	placements, err := server.store.ListAdPlacements(ctx.Request().Context())
+	if err != nil {
+		log.Println("Error listing ad placements in adminAdPlacements:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdPlacementsSort(placements, ""))
+}
+
+func (server *Server) createAdPlacementModal(ctx echo.Context) error {
+	placement := db.AdPlacement{
+		Formats:   []string{utils.AdFormatImage},
+		PageTypes: components.AdPageTypeKeys(),
+		IsActive:  true,
+	}
+
+	return Render(ctx, http.StatusOK, components.AdPlacementModal("", placement, true))
+}
+
+func (server *Server) updateAdPlacementModal(ctx echo.Context) error {
+	placement, err := server.store.GetAdPlacement(ctx.Request().Context(), ctx.Param("key"))
+	if err != nil {
+		log.Println("Error getting ad placement in updateAdPlacementModal:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdPlacementModal("", placement, false))
+}
+
+type AdPlacementReq struct {
+	Key       string   `form:"key" validate:"required,max=50,slotkey"`
+	Name      string   `form:"name" validate:"required,min=2,max=100"`
+	Width     int32    `form:"width" validate:"gte=1,lte=4000"`
+	Height    int32    `form:"height" validate:"gte=1,lte=4000"`
+	Formats   []string `form:"formats" validate:"min=1,dive,oneof=image video"`
+	PageTypes []string `form:"page_types" validate:"dive,oneof=home category tag article search other"`
+	IsActive  bool     `form:"is_active"`
+}
+
+func adPlacementReqErr(err error) components.AdPlacementErr {
+	var placementErr components.AdPlacementErr
+
+	for _, fieldErr := range err.(validator.ValidationErrors) {
+		switch fieldErr.Field() {
+		case "Key":
+			placementErr = "Ključ pozicije sme sadržati samo mala slova, brojeve i crtice."
+		case "Name":
+			placementErr = "Naziv pozicije mora biti između 2 i 100 karaktera."
+		case "Width", "Height":
+			placementErr = "Dimenzije pozicije moraju biti između 1 i 4000 piksela."
+		case "Formats":
+			placementErr = "Odaberite bar jedan format."
+		case "PageTypes":
+			placementErr = "Nepoznata vrsta stranice."
+		}
+	}
+
+	return placementErr
+}
+
+// adPlacementFromReq is the placement as submitted, to fill the form again
+// when it has errors.
+func adPlacementFromReq(req AdPlacementReq) db.AdPlacement {
+	return db.AdPlacement{
+		Key:       req.Key,
+		Name:      req.Name,
+		Width:     req.Width,
+		Height:    req.Height,
+		Formats:   req.Formats,
+		PageTypes: req.PageTypes,
+		IsActive:  req.IsActive,
+	}
+}
+
+func (server *Server) createAdPlacement(ctx echo.Context) error {
+	var req AdPlacementReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in createAdPlacement:", err)
+		return err
+	}
+	// A placement on no page type is kept, just not filled anywhere
+	if req.PageTypes == nil {
+		req.PageTypes = []string{}
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		ctx.Response().Header().Set("HX-Retarget", "#ad-placement-modal")
+		return Render(ctx, http.StatusOK, components.AdPlacementModal(adPlacementReqErr(err), adPlacementFromReq(req), true))
+	}
+
+	_, err := server.store.GetAdPlacement(ctx.Request().Context(), req.Key)
+	if err == nil {
+		ctx.Response().Header().Set("HX-Retarget", "#ad-placement-modal")
+		return Render(ctx, http.StatusOK, components.AdPlacementModal("Pozicija sa ovim ključem već postoji.", adPlacementFromReq(req), true))
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		log.Println("Error getting ad placement in createAdPlacement:", err)
+		return err
+	}
+
+	_, err = server.store.CreateAdPlacement(ctx.Request().Context(), db.CreateAdPlacementParams{
+		Key:       req.Key,
+		Name:      req.Name,
+		Width:     req.Width,
+		Height:    req.Height,
+		Formats:   req.Formats,
+		PageTypes: req.PageTypes,
+		IsActive:  req.IsActive,
+	})
+	if err != nil {
+		log.Println("Error creating ad placement in createAdPlacement:", err)
+		return err
+	}
+
+	server.invalidateAdPlacements(ctx.Request().Context())
+
+	ctx.Response().Header().Add("HX-Trigger", "adPlacementSuccess")
+	return server.adminAdPlacements(ctx)
+}
+
+func (server *Server) updateAdPlacement(ctx echo.Context) error {
+	var req AdPlacementReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in updateAdPlacement:", err)
+		return err
+	}
+	// A placement on no page type is kept, just not filled anywhere
+	if req.PageTypes == nil {
+		req.PageTypes = []string{}
+	}
+	// The key is what templates and ads refer to, it never changes
+	req.Key = ctx.Param("key")
+
+	if err := ctx.Validate(req); err != nil {
+		ctx.Response().Header().Set("HX-Retarget", "#ad-placement-modal")
+		return Render(ctx, http.StatusOK, components.AdPlacementModal(adPlacementReqErr(err), adPlacementFromReq(req), false))
+	}
+
+	_, err := server.store.UpdateAdPlacement(ctx.Request().Context(), db.UpdateAdPlacementParams{
+		Name:      req.Name,
+		Width:     req.Width,
+		Height:    req.Height,
+		Formats:   req.Formats,
+		PageTypes: req.PageTypes,
+		IsActive:  req.IsActive,
+		Key:       req.Key,
+	})
+	if err != nil {
+		log.Println("Error updating ad placement in updateAdPlacement:", err)
+		return err
+	}
+
+	server.invalidateAdPlacements(ctx.Request().Context())
+
+	ctx.Response().Header().Add("HX-Trigger", "adPlacementSuccess")
+	return server.adminAdPlacements(ctx)
+}
+
+// deleteAdPlacement removes a placement no ad is booked in.
+func (server *Server) deleteAdPlacement(ctx echo.Context) error {
+	key := ctx.Param("key")
+
+	count, err := server.store.CountAdsInPlacement(ctx.Request().Context(), pgtype.Text{String: key, Valid: true})
+	if err != nil {
+		log.Println("Error counting ads in deleteAdPlacement:", err)
+		return err
+	}
+	if count > 0 {
+		placements, err := server.store.ListAdPlacements(ctx.Request().Context())
+		if err != nil {
+			log.Println("Error listing ad placements in deleteAdPlacement:", err)
+			return err
+		}
+
+		placementErr := components.AdPlacementErr(fmt.Sprintf("Pozicija %s ima %d oglasa, premestite ih ili obrišite pre brisanja pozicije.", key, count))
+		return Render(ctx, http.StatusOK, components.AdPlacementsSort(placements, placementErr))
+	}
+
+	if err := server.store.DeleteAdPlacement(ctx.Request().Context(), key); err != nil {
+		log.Println("Error deleting ad placement in deleteAdPlacement:", err)
+		return err
+	}
+
+	server.invalidateAdPlacements(ctx.Request().Context())
+
+	return server.adminAdPlacements(ctx)
+}
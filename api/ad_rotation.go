@@ -0,0 +1,214 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// The ads that can be served are cached briefly, impressions towards their
+// goals are counted from the ad stats. Other instances drop their local copy
+// within servableAdsTTL of a change.
+const (
+	servableAdsKey = "ads:servable"
+	servableAdsTag = "ads"
+	servableAdsTTL = 30 * time.Second
+)
+
+// servableAds returns the running campaigns and house ads.
+func (server *Server) servableAds(ctx context.Context) ([]db.ListServableAdsRow, error) {
+	var ads []db.ListServableAdsRow
+
+	found, err := server.cacheService.Get(ctx, servableAdsKey, &ads)
+	if err != nil {
+		log.Println("Error getting servable ads from cache in servableAds:", err)
+	}
+	if found {
+		return ads, nil
+	}
+
+	ads, err = server.store.ListServableAds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.cacheService.SetWithTags(ctx, servableAdsKey, ads, servableAdsTTL, servableAdsTag); err != nil {
+		log.Println("Error caching servable ads in servableAds:", err)
+	}
+
+	return ads, nil
+}
+
+// invalidateServableAds makes the next page load pick up a changed ad.
+func (server *Server) invalidateServableAds(ctx context.Context) {
+	if err := server.cacheService.InvalidateTags(ctx, servableAdsTag); err != nil {
+		log.Println("Error invalidating servable ads in invalidateServableAds:", err)
+	}
+}
+
+// selectAds picks an ad targeted at the page for each placement. Booked
+// campaigns that haven't reached their impression goal come first, house ads
+// fill the placements nothing is booked for.
+func (server *Server) selectAds(ctx echo.Context, ads []db.ListServableAdsRow, placements []string, target adTarget) []db.ListServableAdsRow {
+	var selected []db.ListServableAdsRow
+
+	for _, placement := range placements {
+		var booked, house []db.ListServableAdsRow
+		for _, ad := range ads {
+			if ad.Placement.String != placement || !target.matches(ad) {
+				continue
+			}
+			if ad.IsHouse {
+				house = append(house, ad)
+			} else if ad.ImpressionGoal == 0 || ad.Impressions < ad.ImpressionGoal {
+				booked = append(booked, ad)
+			}
+		}
+
+		if ad, ok := server.pickAd(ctx, booked); ok {
+			selected = append(selected, ad)
+		} else if ad, ok := server.pickAd(ctx, house); ok {
+			selected = append(selected, ad)
+		}
+	}
+
+	return selected
+}
+
+// pickAd draws an ad at random in proportion to its weight, passing over
+// ads the visitor has been served as often today as their frequency cap allows.
+// Only the ad picked counts as served.
+func (server *Server) pickAd(ctx echo.Context, ads []db.ListServableAdsRow) (db.ListServableAdsRow, bool) {
+	candidates := slices.Clone(ads)
+
+	for len(candidates) > 0 {
+		i := weightedIndex(candidates)
+		ad := candidates[i]
+		candidates = slices.Delete(candidates, i, i+1)
+
+		if ad.FrequencyCap == 0 {
+			return ad, true
+		}
+		if server.adServedCount(ctx, ad) < int64(ad.FrequencyCap) {
+			server.countAdServed(ctx, ad)
+			return ad, true
+		}
+	}
+
+	return db.ListServableAdsRow{}, false
+}
+
+func weightedIndex(ads []db.ListServableAdsRow) int {
+	var total int32
+	for _, ad := range ads {
+		total += ad.Weight
+	}
+
+	n := rand.Int31n(total)
+	for i, ad := range ads {
+		n -= ad.Weight
+		if n < 0 {
+			return i
+		}
+	}
+
+	return len(ads) - 1
+}
+
+// adServedKey is the counter of how many times the visitor was served ad today.
+func adServedKey(ctx echo.Context, ad db.ListServableAdsRow) string {
+	day := time.Now().In(Loc).Format("2006-01-02")
+	return fmt.Sprintf("ad:served:%s:%s:%s", ad.ID.String(), day, adVisitor(ctx))
+}
+
+// adServedCount returns how many times the visitor has been served ad today.
+func (server *Server) adServedCount(ctx echo.Context, ad db.ListServableAdsRow) int64 {
+	n, err := server.cacheService.Count(ctx.Request().Context(), adServedKey(ctx, ad))
+	if err != nil {
+		log.Println("Error getting served ads count in adServedCount:", err)
+		return 0
+	}
+
+	return n
+}
+
+// countAdServed counts this serving of ad to the visitor. Outside the normal
+// mode nothing is counted, frequency caps included.
+func (server *Server) countAdServed(ctx echo.Context, ad db.ListServableAdsRow) {
+	if utils.SiteModeFromContext(ctx.Request().Context()).Mode != utils.SiteModeNormal {
+		return
+	}
+
+	if _, err := server.cacheService.Incr(ctx.Request().Context(), adServedKey(ctx, ad), 24*time.Hour); err != nil {
+		log.Println("Error counting served ads in countAdServed:", err)
+	}
+}
+
+type AdSlotsReq struct {
+	Placements []string `query:"placement"`
+	Path       string   `query:"path"`
+	PageType   string   `query:"page_type"`
+	Content    string   `query:"content"`
+}
+
+// adSlots fills the ad slots of a page, see components.AdSlot. Only active
+// placements of the registry that appear on the page's type are filled, with
+// ads targeted at the page.
+func (server *Server) adSlots(ctx echo.Context) error {
+	var req AdSlotsReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in adSlots:", err)
+		return err
+	}
+
+	registry, err := server.adPlacements(ctx.Request().Context())
+	if err != nil {
+		log.Println("Error listing ad placements in adSlots:", err)
+		return err
+	}
+
+	target, err := server.adTarget(ctx.Request().Context(), req)
+	if err != nil {
+		log.Println("Error resolving ad target in adSlots:", err)
+		return err
+	}
+
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	// Pages whose article or category has ads switched off keep their slots empty
+	allowed, err := server.pageAdsAllowed(ctx.Request().Context(), target)
+	if err != nil {
+		log.Println("Error resolving page ads in adSlots:", err)
+		return err
+	}
+	if !allowed {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	var placements []db.AdPlacement
+	var keys []string
+	for _, placement := range registry {
+		if placement.IsActive && slices.Contains(req.Placements, placement.Key) && slices.Contains(placement.PageTypes, target.PageType) {
+			placements = append(placements, placement)
+			keys = append(keys, placement.Key)
+		}
+	}
+
+	ads, err := server.servableAds(ctx.Request().Context())
+	if err != nil {
+		log.Println("Error listing servable ads in adSlots:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdSlots(server.selectAds(ctx, ads, keys, target), placements))
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedIndex(t *testing.T) {
+	ads := []db.ListServableAdsRow{{Weight: 1}, {Weight: 3}, {Weight: 6}}
+
+	const draws = 10000
+	counts := make([]int, len(ads))
+	for i := 0; i < draws; i++ {
+		counts[weightedIndex(ads)]++
+	}
+
+	// Each ad is drawn in proportion to its weight, give or take
+	for i, ad := range ads {
+		want := float64(draws) * float64(ad.Weight) / 10
+		require.InDelta(t, want, float64(counts[i]), want*0.2, "ad %d", i)
+	}
+
+	require.Equal(t, 0, weightedIndex(ads[:1]))
+}
+
+func TestAdPathSlug(t *testing.T) {
+	testCases := []struct {
+		path string
+		slug string
+		ok   bool
+	}{
+		{"/kategorije/vesti", "vesti", true},
+		{"/kategorije/vesti/", "vesti", true},
+		{"/kategorije/dru%C5%A1tvo", "društvo", true},
+		{"/kategorije/", "", false},
+		{"/kategorije/vesti/strana", "", false},
+		{"/oznake/vesti", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			slug, ok := adPathSlug(tc.path, "/kategorije/")
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.slug, slug)
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	adEventImpression = "impression"
+	adEventClick      = "click"
+)
+
+// adStatsDays is the span of the ad report when no dates are picked
+const adStatsDays = 30
+
+// adVisitor identifies a visitor well enough to count their impressions and
+// clicks once a day, without storing who they are.
+func adVisitor(ctx echo.Context) string {
+	sum := sha256.Sum256([]byte(ctx.RealIP() + "|" + ctx.Request().UserAgent()))
+	return hex.EncodeToString(sum[:12])
+}
+
+// firstAdEvent reports whether the visitor's impression or click of an ad is
+// the first of the day, only those are counted.
+func (server *Server) firstAdEvent(ctx echo.Context, event string, ad db.Ad, day time.Time) bool {
+	key := fmt.Sprintf("ad:seen:%s:%s:%s:%s:%s", event, ad.ID.String(), ad.Placement.String, day.Format("2006-01-02"), adVisitor(ctx))
+
+	first, err := server.cacheService.TryLock(ctx.Request().Context(), key, 24*time.Hour)
+	if err != nil {
+		log.Println("Error checking ad visitor in firstAdEvent:", err)
+		return false
+	}
+
+	return first
+}
+
+// adRapidClickWindow is how long after a click further clicks of the same ad
+// from the same IP are discarded, whatever the browser
+const adRapidClickWindow = 10 * time.Second
+
+// countableAdEvent reports whether an impression or click of ad is counted.
+// Bots, inactive ads and repeated events of a visitor aren't.
+func (server *Server) countableAdEvent(ctx echo.Context, event string, ad db.Ad, day time.Time) bool {
+	if utils.IsBot(ctx.Request().UserAgent()) || ad.Status.String != "active" {
+		return false
+	}
+
+	return server.firstAdEvent(ctx, event, ad, day)
+}
+
+// rapidAdClick reports whether the IP clicked the ad moments ago, as click
+// fraud tends to.
+func (server *Server) rapidAdClick(ctx echo.Context, ad db.Ad) bool {
+	key := fmt.Sprintf("ad:click:recent:%s:%s", ad.ID.String(), ctx.RealIP())
+
+	first, err := server.cacheService.TryLock(ctx.Request().Context(), key, adRapidClickWindow)
+	if err != nil {
+		log.Println("Error checking recent ad clicks in rapidAdClick:", err)
+		return false
+	}
+
+	return !first
+}
+
+// recordAdImpression counts an ad the visitor has seen on the page.
+func (server *Server) recordAdImpression(ctx echo.Context) error {
+	adID, err := utils.ParseUUID(ctx.Param("id"), "ad ID")
+	if err != nil {
+		log.Println("Invalid ad ID format in recordAdImpression:", err)
+		return err
+	}
+
+	ad, err := server.store.GetAd(ctx.Request().Context(), adID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		log.Println("Error getting ad in recordAdImpression:", err)
+		return err
+	}
+
+	day := time.Now().In(Loc)
+	if !server.countableAdEvent(ctx, adEventImpression, ad, day) {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	err = server.store.RecordAdImpression(ctx.Request().Context(), db.RecordAdImpressionParams{
+		AdID:      ad.ID,
+		Placement: ad.Placement.String,
+		StatDate:  pgtype.Date{Time: day, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error recording ad impression in recordAdImpression:", err)
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// adRedirect follows a signed ad link: it counts the click and sends the
+// visitor on to the advertiser.
+func (server *Server) adRedirect(ctx echo.Context) error {
+	adIDStr := ctx.Param("id")
+	if !utils.VerifyAdSignature(ctx.QueryParam("sig"), adIDStr) {
+		return echo.NewHTTPError(http.StatusForbidden, "Invalid ad link")
+	}
+
+	adID, err := utils.ParseUUID(adIDStr, "ad ID")
+	if err != nil {
+		log.Println("Invalid ad ID format in adRedirect:", err)
+		return err
+	}
+
+	ad, err := server.store.GetAd(ctx.Request().Context(), adID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "Ad not found")
+		}
+		log.Println("Error getting ad in adRedirect:", err)
+		return err
+	}
+	if ad.TargetUrl.String == "" {
+		return echo.NewHTTPError(http.StatusNotFound, "Ad not found")
+	}
+
+	if !server.rapidAdClick(ctx, ad) {
+		server.recordAdClick(ctx, ad)
+	}
+
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return ctx.Redirect(http.StatusFound, ad.TargetUrl.String)
+}
+
+// recordAdClick counts a click for the ad's report as well as the ad's total
+// and the sitewide daily analytics. The visitor is redirected either way, so
+// errors are only logged.
+func (server *Server) recordAdClick(ctx echo.Context, ad db.Ad) {
+	// Clicks are writes too, they wait for the site to be back to normal
+	if utils.SiteModeFromContext(ctx.Request().Context()).Mode != utils.SiteModeNormal {
+		return
+	}
+
+	day := time.Now().In(Loc)
+	if !server.countableAdEvent(ctx, adEventClick, ad, day) {
+		return
+	}
+
+	err := server.store.RecordAdClick(ctx.Request().Context(), db.RecordAdClickParams{
+		AdID:      ad.ID,
+		Placement: ad.Placement.String,
+		StatDate:  pgtype.Date{Time: day, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error recording ad click in recordAdClick:", err)
+		return
+	}
+
+	_, err = server.store.IncrementAdClicks(ctx.Request().Context(), ad.ID)
+	if err != nil {
+		log.Println("Error incrementing ad clicks in recordAdClick:", err)
+	}
+
+	err = server.incrementDailyAdsClicks(ctx)
+	if err != nil {
+		log.Println("Error incrementing daily ads clicks in recordAdClick:", err)
+	}
+}
+
+type AdStatsReq struct {
+	From string `query:"from"`
+	To   string `query:"to"`
+}
+
+// adStatsRange returns the days the report covers, the last 30 days unless
+// the admin picked others.
+func adStatsRange(req AdStatsReq) (time.Time, time.Time) {
+	now := time.Now().In(Loc)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, Loc)
+	from := to.AddDate(0, 0, -(adStatsDays - 1))
+
+	if t, err := time.ParseInLocation("2006-01-02", req.To, Loc); err == nil {
+		to = t
+	}
+	if t, err := time.ParseInLocation("2006-01-02", req.From, Loc); err == nil {
+		from = t
+	}
+	if from.After(to) {
+		from, to = to, from
+	}
+
+	return from, to
+}
+
+// adStats is the CTR report of every ad in the admin's ads page.
+func (server *Server) adStats(ctx echo.Context) error {
+	var req AdStatsReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in adStats:", err)
+		return err
+	}
+
+	from, to := adStatsRange(req)
+
+	stats, err := server.store.ListAdStats(ctx.Request().Context(), db.ListAdStatsParams{
+		StartDate: pgtype.Date{Time: from, Valid: true},
+		EndDate:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error listing ad stats in adStats:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdStatsSort(stats, from, to))
+}
+
+// exportAdStats downloads the report as CSV, one row per ad, placement and day.
+func (server *Server) exportAdStats(ctx echo.Context) error {
+	var req AdStatsReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in exportAdStats:", err)
+		return err
+	}
+
+	from, to := adStatsRange(req)
+
+	stats, err := server.store.ListAdDailyStats(ctx.Request().Context(), db.ListAdDailyStatsParams{
+		StartDate: pgtype.Date{Time: from, Valid: true},
+		EndDate:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error listing daily ad stats in exportAdStats:", err)
+		return err
+	}
+
+	filename := fmt.Sprintf("oglasi-%s-%s.csv", from.Format("2006-01-02"), to.Format("2006-01-02"))
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	res.WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(res)
+	w.Write([]string{"datum", "oglas_id", "oglas", "pozicija", "prikazi", "klikovi", "ctr"})
+	for _, stat := range stats {
+		w.Write([]string{
+			stat.StatDate.Time.Format("2006-01-02"),
+			stat.ID.String(),
+			stat.Title.String,
+			stat.Placement,
+			strconv.Itoa(int(stat.Impressions)),
+			strconv.Itoa(int(stat.Clicks)),
+			components.AdCTR(stat.Impressions, stat.Clicks),
+		})
+	}
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		log.Println("Error writing csv in exportAdStats:", err)
+		return err
+	}
+
+	return nil
+}
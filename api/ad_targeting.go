@@ -0,0 +1,211 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// AdTargetingReq are the targeting rules of the ad forms. Empty lists match
+// every page, the daypart is in Belgrade hours and wraps past midnight when
+// it starts after it ends.
+type AdTargetingReq struct {
+	TargetCategories  []string `form:"target_categories"`
+	ExcludeCategories []string `form:"exclude_categories"`
+	TargetTags        []string `form:"target_tags"`
+	ExcludeTags       []string `form:"exclude_tags"`
+	TargetPageTypes   []string `form:"target_page_types" validate:"dive,oneof=home category tag article search other"`
+	ExcludePageTypes  []string `form:"exclude_page_types" validate:"dive,oneof=home category tag article search other"`
+	DaypartStart      int32    `form:"daypart_start" validate:"gte=0,lte=23"`
+	DaypartEnd        int32    `form:"daypart_end" validate:"gte=1,lte=24,nefield=DaypartStart"`
+}
+
+func parseUUIDs(ids []string, fieldName string) ([]pgtype.UUID, error) {
+	uuids := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := utils.ParseUUID(id, fieldName)
+		if err != nil {
+			return nil, err
+		}
+		uuids = append(uuids, parsed)
+	}
+	return uuids, nil
+}
+
+// params turns the rules into what is stored for the ad.
+func (req AdTargetingReq) params(adID pgtype.UUID) (db.UpsertAdTargetingParams, error) {
+	arg := db.UpsertAdTargetingParams{
+		AdID:             adID,
+		TargetPageTypes:  append([]string{}, req.TargetPageTypes...),
+		ExcludePageTypes: append([]string{}, req.ExcludePageTypes...),
+		DaypartStart:     req.DaypartStart,
+		DaypartEnd:       req.DaypartEnd,
+	}
+
+	var err error
+	if arg.TargetCategories, err = parseUUIDs(req.TargetCategories, "category ID"); err != nil {
+		return arg, err
+	}
+	if arg.ExcludeCategories, err = parseUUIDs(req.ExcludeCategories, "category ID"); err != nil {
+		return arg, err
+	}
+	if arg.TargetTags, err = parseUUIDs(req.TargetTags, "tag ID"); err != nil {
+		return arg, err
+	}
+	if arg.ExcludeTags, err = parseUUIDs(req.ExcludeTags, "tag ID"); err != nil {
+		return arg, err
+	}
+
+	return arg, nil
+}
+
+// adTarget is the page an ad is picked for and when.
+type adTarget struct {
+	PageType   string
+	ContentID  pgtype.UUID
+	CategoryID pgtype.UUID
+	TagIDs     []pgtype.UUID
+	Hour       int
+}
+
+// adTarget reads the page's ad context, see components.AdContext. Pages
+// without one are typed by their path. What the page is about comes from the
+// database, an article's category and tags are looked up by its ID and
+// category and tag pages by the slug in their path.
+func (server *Server) adTarget(ctx context.Context, req AdSlotsReq) (adTarget, error) {
+	target := adTarget{
+		PageType: req.PageType,
+		Hour:     time.Now().In(Loc).Hour(),
+	}
+	if !slices.Contains(components.AdPageTypeKeys(), target.PageType) {
+		target.PageType = adPageType(req.Path)
+	}
+
+	if contentID, err := utils.ParseUUID(req.Content, "content ID"); err == nil {
+		content, err := server.store.GetContentDetails(ctx, contentID)
+		switch {
+		case err == nil:
+			target.PageType = "article"
+			target.ContentID = content.ContentID
+			target.CategoryID = content.CategoryID
+
+			tags, err := server.store.GetTagsByContent(ctx, content.ContentID)
+			if err != nil {
+				return target, err
+			}
+			for _, tag := range tags {
+				target.TagIDs = append(target.TagIDs, tag.TagID)
+			}
+			return target, nil
+		case !errors.Is(err, pgx.ErrNoRows):
+			return target, err
+		}
+	}
+
+	switch target.PageType {
+	case "category":
+		slug, ok := adPathSlug(req.Path, "/kategorije/")
+		if !ok {
+			break
+		}
+		category, err := server.store.GetCategoryBySlug(ctx, slug)
+		if err == nil {
+			target.CategoryID = category.CategoryID
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return target, err
+		}
+	case "tag":
+		slug, ok := adPathSlug(req.Path, "/oznake/")
+		if !ok {
+			break
+		}
+		tag, err := server.store.GetTagBySlug(ctx, slug)
+		if err == nil {
+			target.TagIDs = []pgtype.UUID{tag.TagID}
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return target, err
+		}
+	}
+
+	return target, nil
+}
+
+// adPathSlug returns the slug of a page at prefix + slug.
+func adPathSlug(path, prefix string) (string, bool) {
+	slug, ok := strings.CutPrefix(path, prefix)
+	if !ok {
+		return "", false
+	}
+	slug, err := url.PathUnescape(strings.TrimSuffix(slug, "/"))
+	if err != nil || slug == "" || strings.Contains(slug, "/") {
+		return "", false
+	}
+	return slug, true
+}
+
+// matches reports whether ad may be served on the page at this hour.
+func (target adTarget) matches(ad db.ListServableAdsRow) bool {
+	if len(ad.TargetPageTypes) > 0 && !slices.Contains(ad.TargetPageTypes, target.PageType) {
+		return false
+	}
+	if slices.Contains(ad.ExcludePageTypes, target.PageType) {
+		return false
+	}
+
+	if len(ad.TargetCategories) > 0 && !slices.Contains(ad.TargetCategories, target.CategoryID) {
+		return false
+	}
+	if target.CategoryID.Valid && slices.Contains(ad.ExcludeCategories, target.CategoryID) {
+		return false
+	}
+
+	if len(ad.TargetTags) > 0 && !anyUUID(ad.TargetTags, target.TagIDs) {
+		return false
+	}
+	if anyUUID(ad.ExcludeTags, target.TagIDs) {
+		return false
+	}
+
+	return inDaypart(target.Hour, int(ad.DaypartStart), int(ad.DaypartEnd))
+}
+
+func anyUUID(set, ids []pgtype.UUID) bool {
+	for _, id := range ids {
+		if slices.Contains(set, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// inDaypart reports whether hour falls between start and end, end excluded.
+func inDaypart(hour, start, end int) bool {
+	switch {
+	case start < end:
+		return hour >= start && hour < end
+	case start > end:
+		return hour >= start || hour < end
+	default:
+		return true
+	}
+}
+
+// saveAdTargeting stores the targeting rules of an ad.
+func (server *Server) saveAdTargeting(ctx echo.Context, adID pgtype.UUID, req AdTargetingReq) error {
+	arg, err := req.params(adID)
+	if err != nil {
+		return err
+	}
+
+	return server.store.UpsertAdTargeting(ctx.Request().Context(), arg)
+}
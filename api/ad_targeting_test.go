@@ -0,0 +1,82 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInDaypart(t *testing.T) {
+	testCases := []struct {
+		name       string
+		hour       int
+		start, end int
+		in         bool
+	}{
+		{"inside", 10, 8, 16, true},
+		{"at start", 8, 8, 16, true},
+		{"at end", 16, 8, 16, false},
+		{"before", 7, 8, 16, false},
+		{"whole day", 23, 0, 24, true},
+		{"past midnight, evening", 23, 22, 6, true},
+		{"past midnight, morning", 5, 22, 6, true},
+		{"past midnight, at end", 6, 22, 6, false},
+		{"past midnight, midday", 12, 22, 6, false},
+		{"no daypart", 3, 0, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.in, inDaypart(tc.hour, tc.start, tc.end))
+		})
+	}
+}
+
+func testUUID(b byte) pgtype.UUID {
+	return pgtype.UUID{Bytes: [16]byte{b}, Valid: true}
+}
+
+func TestAdTargetMatches(t *testing.T) {
+	vesti, sport := testUUID(1), testUUID(2)
+	izbori, fudbal := testUUID(3), testUUID(4)
+
+	target := adTarget{
+		PageType:   "article",
+		CategoryID: vesti,
+		TagIDs:     []pgtype.UUID{izbori},
+		Hour:       10,
+	}
+
+	testCases := []struct {
+		name  string
+		ad    db.ListServableAdsRow
+		match bool
+	}{
+		{"untargeted", db.ListServableAdsRow{}, true},
+		{"page type", db.ListServableAdsRow{TargetPageTypes: []string{"home", "article"}}, true},
+		{"other page type", db.ListServableAdsRow{TargetPageTypes: []string{"home"}}, false},
+		{"excluded page type", db.ListServableAdsRow{ExcludePageTypes: []string{"article"}}, false},
+		{"category", db.ListServableAdsRow{TargetCategories: []pgtype.UUID{vesti}}, true},
+		{"other category", db.ListServableAdsRow{TargetCategories: []pgtype.UUID{sport}}, false},
+		{"excluded category", db.ListServableAdsRow{ExcludeCategories: []pgtype.UUID{vesti}}, false},
+		{"tag", db.ListServableAdsRow{TargetTags: []pgtype.UUID{fudbal, izbori}}, true},
+		{"other tag", db.ListServableAdsRow{TargetTags: []pgtype.UUID{fudbal}}, false},
+		{"excluded tag", db.ListServableAdsRow{ExcludeTags: []pgtype.UUID{izbori}}, false},
+		{"daypart", db.ListServableAdsRow{DaypartStart: 8, DaypartEnd: 12}, true},
+		{"outside daypart", db.ListServableAdsRow{DaypartStart: 18, DaypartEnd: 6}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.match, target.matches(tc.ad))
+		})
+	}
+
+	// A page about nothing in particular gets no ads targeted at categories or tags
+	home := adTarget{PageType: "home", Hour: 10}
+	require.False(t, home.matches(db.ListServableAdsRow{TargetCategories: []pgtype.UUID{vesti}}))
+	require.False(t, home.matches(db.ListServableAdsRow{TargetTags: []pgtype.UUID{izbori}}))
+	require.True(t, home.matches(db.ListServableAdsRow{ExcludeCategories: []pgtype.UUID{vesti}}))
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -14,6 +15,7 @@ import (
 	"github.com/00mark0/macva-press/utils"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/labstack/echo/v4"
 )
@@ -60,14 +62,83 @@ func (server *Server) listInactiveAds(ctx echo.Context) error {
 	return Render(ctx, http.StatusOK, components.Ads(int(nextLimit), inactiveAds, url))
 }
 
+// adFormData lists what the ad forms offer to choose from.
+func (server *Server) adFormData(ctx echo.Context) (components.AdFormData, error) {
+	var data components.AdFormData
+	var err error
+
+	data.Placements, err = server.store.ListAdPlacements(ctx.Request().Context())
+	if err != nil {
+		return data, err
+	}
+
+	data.Categories, err = server.store.ListCategories(ctx.Request().Context(), 1000)
+	if err != nil {
+		return data, err
+	}
+
+	data.Tags, err = server.store.ListTags(ctx.Request().Context(), 1000)
+	if err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+// adTargeting returns the targeting rules of an ad, ads created before
+// targeting existed have none and are served everywhere.
+func (server *Server) adTargeting(ctx echo.Context, adID pgtype.UUID) (db.AdTargeting, error) {
+	targeting, err := server.store.GetAdTargeting(ctx.Request().Context(), adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.AdTargeting{AdID: adID, DaypartEnd: 24}, nil
+	}
+
+	return targeting, err
+}
+
+// createAdModalErr shows the new ad form again with err.
+func (server *Server) createAdModalErr(ctx echo.Context, createAdErr components.CreateAdErr) error {
+	data, err := server.adFormData(ctx)
+	if err != nil {
+		log.Println("Error getting ad form data in createAdModalErr:", err)
+		return err
+	}
+
+	ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
+	return Render(ctx, http.StatusOK, components.CreateAdModal(createAdErr, data))
+}
+
+// updateAdModalErr shows the ad's form again with err.
+func (server *Server) updateAdModalErr(ctx echo.Context, updateAdErr components.UpdateAdErr, ad db.Ad) error {
+	data, err := server.adFormData(ctx)
+	if err != nil {
+		log.Println("Error getting ad form data in updateAdModalErr:", err)
+		return err
+	}
+
+	targeting, err := server.adTargeting(ctx, ad.ID)
+	if err != nil {
+		log.Println("Error getting ad targeting in updateAdModalErr:", err)
+		return err
+	}
+
+	ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
+	return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, ad, targeting, data))
+}
+
 type CreateAdReq struct {
-	Title       string `form:"title" validate:"required,min=3,max=50"`
-	Description string `form:"description" validate:"required,min=3,max=100"`
-	TargetUrl   string `form:"target_url" validate:"required"`
-	Placement   string `form:"placement" validate:"required"`
-	Status      string `form:"status" validate:"required"`
-	StartDate   string `form:"start_date" validate:"required"`
-	EndDate     string `form:"end_date" validate:"required"`
+	Title          string `form:"title" validate:"required,min=3,max=50"`
+	Description    string `form:"description" validate:"required,min=3,max=100"`
+	TargetUrl      string `form:"target_url" validate:"required"`
+	Placement      string `form:"placement" validate:"required"`
+	Status         string `form:"status" validate:"required"`
+	StartDate      string `form:"start_date" validate:"required"`
+	EndDate        string `form:"end_date" validate:"required"`
+	Weight         int32  `form:"weight" validate:"gte=1,lte=100"`
+	FrequencyCap   int32  `form:"frequency_cap" validate:"gte=0,lte=1000"`
+	ImpressionGoal int32  `form:"impression_goal" validate:"gte=0"`
+	IsHouse        bool   `form:"is_house"`
+	AdTargetingReq
 }
 
 func (server *Server) createAd(ctx echo.Context) error {
@@ -105,18 +176,36 @@ func (server *Server) createAd(ctx echo.Context) error {
 				createAddErr = "Datum početka oglasa je obavezan."
 			case "EndDate":
 				createAddErr = "Datum završetka oglasa je obavezan."
+			case "Weight":
+				createAddErr = "Težina oglasa mora biti između 1 i 100."
+			case "FrequencyCap":
+				createAddErr = "Broj prikazivanja po posetiocu mora biti između 0 i 1000."
+			case "ImpressionGoal":
+				createAddErr = "Cilj prikaza ne može biti negativan."
+			case "TargetPageTypes", "ExcludePageTypes":
+				createAddErr = "Nepoznata vrsta stranice."
+			case "DaypartStart", "DaypartEnd":
+				createAddErr = "Sati prikazivanja moraju biti od 0 do 24, a početak različit od kraja."
 			}
 		}
 
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
+		return server.createAdModalErr(ctx, createAddErr)
+	}
+
+	placement, err := server.store.GetAdPlacement(ctx.Request().Context(), req.Placement)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			createAddErr = "Izabrana pozicija ne postoji."
+			return server.createAdModalErr(ctx, createAddErr)
+		}
+		log.Println("Error getting ad placement in createAd:", err)
+		return err
 	}
 
 	file, err := ctx.FormFile("image_url")
 	if err != nil {
 		createAddErr = "Slika oglasa je obavezna."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
+		return server.createAdModalErr(ctx, createAddErr)
 	}
 
 	uploadsDir := "static/ads"
@@ -150,11 +239,18 @@ func (server *Server) createAd(ctx echo.Context) error {
 		return err
 	}
 
+	if creativeErr := adCreativeErr(filePath, placement); creativeErr != "" {
+		if err := os.Remove(filePath); err != nil {
+			log.Println("Warning: could not delete rejected ad file:", err)
+		}
+		return server.createAdModalErr(ctx, components.CreateAdErr(creativeErr))
+	}
+
 	// Determine if uploaded file is an image before converting to WebP
 	isImage := strings.HasPrefix(file.Header.Get("Content-Type"), "image/")
 
 	if isImage {
-		convertedPath, err := ConvertToWebPWithResize(filePath, 800, 600, 80)
+		convertedPath, err := ConvertToWebPWithResize(filePath, int(placement.Width), int(placement.Height), 80)
 		if err != nil {
 			log.Println("Error converting file to WebP in createAd:", err)
 		} else {
@@ -177,8 +273,7 @@ func (server *Server) createAd(ctx echo.Context) error {
 	// 1. Start date must be before end date.
 	if startDate.After(endDate) {
 		createAddErr = "Datum početka oglasa mora biti pre datuma završetka."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
+		return server.createAdModalErr(ctx, createAddErr)
 	}
 
 	// 2. Start date must not be in the past (you might consider allowing today by comparing to midnight or adding a small margin).
@@ -186,107 +281,34 @@ func (server *Server) createAd(ctx echo.Context) error {
 	midnightNow := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, Loc)
 	if startDate.Before(midnightNow) {
 		createAddErr = "Datum početka oglasa mora biti veći od trenutnog datuma."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
+		return server.createAdModalErr(ctx, createAddErr)
 	}
 
 	// 3. End date must not be in the past.
 	if endDate.Before(midnightNow) {
 		createAddErr = "Datum završetka oglasa mora biti veći od trenutnog datuma."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
+		return server.createAdModalErr(ctx, createAddErr)
 	}
 
 	// 4. Start date must not be more than one year in the future.
 	maxFutureDate := midnightNow.AddDate(1, 0, 0)
 	if startDate.After(maxFutureDate) {
 		createAddErr = "Datum početka oglasa ne moze biti više od godinu dana unapred."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
+		return server.createAdModalErr(ctx, createAddErr)
 	}
 
 	// 5. The duration between start and end must be at least 3 days.
 	minDuration := 3 * 24 * time.Hour
 	if endDate.Sub(startDate) < minDuration {
 		createAddErr = "Razmak između početka i kraja oglasa mora biti barem 3 dana."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
+		return server.createAdModalErr(ctx, createAddErr)
 	}
 
 	// 6. End date must not be more than 5 years in the future.
 	maxEndDate := midnightNow.AddDate(5, 0, 0)
 	if endDate.After(maxEndDate) {
 		createAddErr = "Datum kraja oglasa ne može biti više od 5 godina u budućnosti."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
-	}
-
-	scheduledAds, err := server.store.ListScheduledAds(ctx.Request().Context(), 100)
-	if err != nil {
-		log.Println("Error listing scheduled ads in createAd:", err)
-		return err
-	}
-
-	for _, ad := range scheduledAds {
-		if req.Status == "active" && req.Placement == ad.Placement.String && startDate.After(midnightNow.Add(24*time.Hour)) {
-			createAddErr = "Već postoji zakazani oglas za ovu poziciju."
-			ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-			return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
-		}
-	}
-
-	activeAds, err := server.store.ListActiveAds(ctx.Request().Context(), 100)
-	if err != nil {
-		log.Println("Error listing active ads in createAd:", err)
-		return err
-	}
-
-	for _, ad := range activeAds {
-		if req.Status == "active" && req.Placement == ad.Placement.String && startDate.After(midnightNow) && startDate.Before(midnightNow.Add(24*time.Hour)) {
-			createAddErr = "Već postoji aktivan oglas za ovu poziciju."
-			ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-			return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
-		}
-
-		// Check for overlaps between new scheduled ads and existing active ads
-		if req.Status == "active" {
-			// If same position and the existing ad's end date is after the new ad's start date
-			if req.Placement == ad.Placement.String && ad.EndDate.Time.After(startDate) {
-				// Calc duration before adjustment to make up for snipped time
-				duration := endDate.Sub(startDate)
-
-				// Adjust start date to midnight of the day after the existing ad ends
-				adjustedStartDate := time.Date(
-					ad.EndDate.Time.Year(),
-					ad.EndDate.Time.Month(),
-					ad.EndDate.Time.Day()+1,
-					0, 0, 0, 0,
-					Loc,
-				)
-
-				// Update the start date
-				startDate = adjustedStartDate
-
-				// Also update end date to maintain the original duration
-				endDate = adjustedStartDate.Add(duration)
-
-				// You might want to inform the user about this adjustment
-				ctx.Response().Header().Add("HX-Trigger", "adDatesAdjusted")
-				break // Stop after finding the first conflict
-			}
-		}
-	}
-
-	if len(activeAds) >= 11 && startDate.After(midnightNow) && startDate.Before(midnightNow.Add(24*time.Hour)) {
-		createAddErr = "Maksimalan broj aktivnih oglasa je 11."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
-	}
-
-	if len(scheduledAds) >= 11 && startDate.After(midnightNow.Add(24*time.Hour)) {
-		createAddErr = "Maksimalan broj zakazanih oglasa je 11."
-		ctx.Response().Header().Set("HX-Retarget", "#create-ad-modal")
-		return Render(ctx, http.StatusOK, components.CreateAdModal(createAddErr))
+		return server.createAdModalErr(ctx, createAddErr)
 	}
 
 	arg := db.CreateAdParams{
@@ -310,14 +332,26 @@ func (server *Server) createAd(ctx echo.Context) error {
 			Time:  endDate,
 			Valid: true,
 		},
+		Weight:         req.Weight,
+		FrequencyCap:   req.FrequencyCap,
+		ImpressionGoal: req.ImpressionGoal,
+		IsHouse:        req.IsHouse,
 	}
 
-	_, err = server.store.CreateAd(ctx.Request().Context(), arg)
+	ad, err := server.store.CreateAd(ctx.Request().Context(), arg)
 	if err != nil {
 		log.Println("Error creating ad in createAd:", err)
 		return err
 	}
 
+	if err := server.saveAdTargeting(ctx, ad.ID, req.AdTargetingReq); err != nil {
+		log.Println("Error saving ad targeting in createAd:", err)
+		return err
+	}
+
+	server.invalidateServableAds(ctx.Request().Context())
+	server.transitionAds(ctx.Request().Context())
+
 	if req.Status == "active" && startDate.After(midnightNow) && startDate.Before(midnightNow.Add(24*time.Hour)) {
 		ctx.Response().Header().Add("HX-Trigger", "createAdSuccess")
 		return server.activeAdsList(ctx)
@@ -332,14 +366,19 @@ func (server *Server) createAd(ctx echo.Context) error {
 
 // UpdateAdReq - request structure for updating an ad
 type UpdateAdReq struct {
-	ID          string `form:"id"`
-	Title       string `form:"title" validate:"required,min=3,max=50"`
-	Description string `form:"description" validate:"required,min=3,max=100"`
-	TargetUrl   string `form:"target_url" validate:"required,url"`
-	Placement   string `form:"placement" validate:"required,oneof=header sidebar-1 sidebar-2 sidebar-3 sidebar-3 sidebar-4 sidebar-5 sidebar-6 sidebar-7 sidebar-8 footer article"`
-	Status      string `form:"status" validate:"required,oneof=active inactive"`
-	StartDate   string `form:"start_date" validate:"required"`
-	EndDate     string `form:"end_date" validate:"required"`
+	ID             string `form:"id"`
+	Title          string `form:"title" validate:"required,min=3,max=50"`
+	Description    string `form:"description" validate:"required,min=3,max=100"`
+	TargetUrl      string `form:"target_url" validate:"required,url"`
+	Placement      string `form:"placement" validate:"required"`
+	Status         string `form:"status" validate:"required,oneof=active inactive"`
+	StartDate      string `form:"start_date" validate:"required"`
+	EndDate        string `form:"end_date" validate:"required"`
+	Weight         int32  `form:"weight" validate:"gte=1,lte=100"`
+	FrequencyCap   int32  `form:"frequency_cap" validate:"gte=0,lte=1000"`
+	ImpressionGoal int32  `form:"impression_goal" validate:"gte=0"`
+	IsHouse        bool   `form:"is_house"`
+	AdTargetingReq
 }
 
 func (server *Server) updateAd(ctx echo.Context) error {
@@ -390,18 +429,41 @@ func (server *Server) updateAd(ctx echo.Context) error {
 				updateAdErr = "Datum početka oglasa je obavezan."
 			case "EndDate":
 				updateAdErr = "Datum završetka oglasa je obavezan."
+			case "Weight":
+				updateAdErr = "Težina oglasa mora biti između 1 i 100."
+			case "FrequencyCap":
+				updateAdErr = "Broj prikazivanja po posetiocu mora biti između 0 i 1000."
+			case "ImpressionGoal":
+				updateAdErr = "Cilj prikaza ne može biti negativan."
+			case "TargetPageTypes", "ExcludePageTypes":
+				updateAdErr = "Nepoznata vrsta stranice."
+			case "DaypartStart", "DaypartEnd":
+				updateAdErr = "Sati prikazivanja moraju biti od 0 do 24, a početak različit od kraja."
 			}
 		}
 
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
+		return server.updateAdModalErr(ctx, updateAdErr, existingAd)
+	}
+
+	placement, err := server.store.GetAdPlacement(ctx.Request().Context(), req.Placement)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			updateAdErr = "Izabrana pozicija ne postoji."
+			return server.updateAdModalErr(ctx, updateAdErr, existingAd)
+		}
+		log.Println("Error getting ad placement in updateAd:", err)
+		return err
 	}
 
 	// Handle image upload if a new file is provided
 	var filePath string
 	file, err := ctx.FormFile("image_url")
 	if err != nil {
-		// No new file uploaded, keep the existing image
+		// No new file uploaded, keep the existing image if it suits the placement
+		if req.Placement != existingAd.Placement.String && !server.adCreativeSuits(ctx, existingAd, placement) {
+			updateAdErr = components.UpdateAdErr(fmt.Sprintf("Pozicija %s traži drugačiju sliku, otpremite novu.", placement.Name))
+			return server.updateAdModalErr(ctx, updateAdErr, existingAd)
+		}
 		filePath = existingAd.ImageUrl.String
 	} else {
 		// New file uploaded, process it
@@ -436,11 +498,18 @@ func (server *Server) updateAd(ctx echo.Context) error {
 			return err
 		}
 
+		if creativeErr := adCreativeErr(filePath, placement); creativeErr != "" {
+			if err := os.Remove(filePath); err != nil {
+				log.Println("Warning: could not delete rejected ad file:", err)
+			}
+			return server.updateAdModalErr(ctx, components.UpdateAdErr(creativeErr), existingAd)
+		}
+
 		// Determine if uploaded file is an image before converting to WebP
 		isImage := strings.HasPrefix(file.Header.Get("Content-Type"), "image/")
 
 		if isImage {
-			convertedPath, err := ConvertToWebPWithResize(filePath, 800, 600, 80)
+			convertedPath, err := ConvertToWebPWithResize(filePath, int(placement.Width), int(placement.Height), 80)
 			if err != nil {
 				log.Println("Error converting file to WebP in createAd:", err)
 			} else {
@@ -472,8 +541,7 @@ func (server *Server) updateAd(ctx echo.Context) error {
 	// 1. Start date must be before end date.
 	if startDate.After(endDate) {
 		updateAdErr = "Datum početka oglasa mora biti pre datuma završetka."
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
+		return server.updateAdModalErr(ctx, updateAdErr, existingAd)
 	}
 
 	// 2. Start date must not be in the past (you might consider allowing today by comparing to midnight or adding a small margin).
@@ -483,121 +551,34 @@ func (server *Server) updateAd(ctx echo.Context) error {
 	// For updates, allow the start date to be in the past if it's the same as the existing start date
 	if startDate.Before(midnightNow) && !startDate.Equal(existingAd.StartDate.Time) {
 		updateAdErr = "Datum početka oglasa mora biti veći od trenutnog datuma."
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
+		return server.updateAdModalErr(ctx, updateAdErr, existingAd)
 	}
 
 	// 3. End date must not be in the past.
 	if endDate.Before(midnightNow) {
 		updateAdErr = "Datum završetka oglasa mora biti veći od trenutnog datuma."
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
+		return server.updateAdModalErr(ctx, updateAdErr, existingAd)
 	}
 
 	// 4. Start date must not be more than one year in the future.
 	maxFutureDate := midnightNow.AddDate(1, 0, 0)
 	if startDate.After(maxFutureDate) {
 		updateAdErr = "Datum početka oglasa ne moze biti više od godinu dana unapred."
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
+		return server.updateAdModalErr(ctx, updateAdErr, existingAd)
 	}
 
 	// 5. The duration between start and end must be at least 3 days.
 	minDuration := 3 * 24 * time.Hour
 	if endDate.Sub(startDate) < minDuration {
 		updateAdErr = "Razmak između početka i kraja oglasa mora biti barem 3 dana."
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
+		return server.updateAdModalErr(ctx, updateAdErr, existingAd)
 	}
 
 	// 6. End date must not be more than 5 years in the future.
 	maxEndDate := midnightNow.AddDate(5, 0, 0)
 	if endDate.After(maxEndDate) {
 		updateAdErr = "Datum kraja oglasa ne može biti više od 5 godina u budućnosti."
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
-	}
-
-	scheduledAds, err := server.store.ListScheduledAds(ctx.Request().Context(), 100)
-	if err != nil {
-		log.Println("Error listing scheduled ads in updateAd:", err)
-		return err
-	}
-
-	for _, ad := range scheduledAds {
-		if req.Status == "active" && req.Placement == ad.Placement.String && startDate.After(midnightNow.Add(24*time.Hour)) && ad.ID != existingAd.ID {
-			updateAdErr = "Već postoji zakazani oglas za ovu poziciju."
-			ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-			return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
-		}
-	}
-
-	activeAds, err := server.store.ListActiveAds(ctx.Request().Context(), 100)
-	if err != nil {
-		log.Println("Error listing active ads in updateAd:", err)
-		return err
-	}
-
-	for _, ad := range activeAds {
-		if req.Status == "active" && req.Placement == ad.Placement.String && startDate.After(midnightNow) && startDate.Before(midnightNow.Add(24*time.Hour)) && ad.ID != existingAd.ID {
-			updateAdErr = "Već postoji aktivan oglas za ovu poziciju."
-			ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-			return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
-		}
-
-		// Check for overlaps between updated ad and existing active ads
-		if req.Status == "active" && ad.ID != existingAd.ID {
-			// If same position and the existing ad's end date is after the new ad's start date
-			if req.Placement == ad.Placement.String && ad.EndDate.Time.After(startDate) {
-				// Calc duration before adjustment to make up for snipped time
-				duration := endDate.Sub(startDate)
-
-				// Adjust start date to midnight of the day after the existing ad ends
-				adjustedStartDate := time.Date(
-					ad.EndDate.Time.Year(),
-					ad.EndDate.Time.Month(),
-					ad.EndDate.Time.Day()+1,
-					0, 0, 0, 0,
-					Loc,
-				)
-
-				// Update the start date
-				startDate = adjustedStartDate
-
-				// Also update end date to maintain the original duration
-				endDate = adjustedStartDate.Add(duration)
-
-				// You might want to inform the user about this adjustment
-				ctx.Response().Header().Add("HX-Trigger", "adDatesAdjusted")
-				break // Stop after finding the first conflict
-			}
-		}
-	}
-
-	// Count ads without including the current one
-	var activeCount, scheduledCount int
-	for _, ad := range activeAds {
-		if ad.ID != adID {
-			activeCount++
-		}
-	}
-
-	for _, ad := range scheduledAds {
-		if ad.ID != adID {
-			scheduledCount++
-		}
-	}
-
-	if activeCount >= 11 && req.Status == "active" && startDate.After(midnightNow) && startDate.Before(midnightNow.Add(24*time.Hour)) {
-		updateAdErr = "Maksimalan broj aktivnih oglasa je 4."
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
-	}
-
-	if scheduledCount >= 11 && req.Status == "active" && startDate.After(midnightNow.Add(24*time.Hour)) {
-		updateAdErr = "Maksimalan broj zakazanih oglasa je 4."
-		ctx.Response().Header().Set("HX-Retarget", "#update-ad-modal")
-		return Render(ctx, http.StatusOK, components.UpdateAdModal(updateAdErr, existingAd))
+		return server.updateAdModalErr(ctx, updateAdErr, existingAd)
 	}
 
 	// Ensure ImageUrl does not have a double leading slash
@@ -641,6 +622,10 @@ func (server *Server) updateAd(ctx echo.Context) error {
 			Time:  endDate,
 			Valid: true,
 		},
+		Weight:         req.Weight,
+		FrequencyCap:   req.FrequencyCap,
+		ImpressionGoal: req.ImpressionGoal,
+		IsHouse:        req.IsHouse,
 	}
 
 	_, err = server.store.UpdateAd(ctx.Request().Context(), arg)
@@ -649,6 +634,14 @@ func (server *Server) updateAd(ctx echo.Context) error {
 		return err
 	}
 
+	if err := server.saveAdTargeting(ctx, adID, req.AdTargetingReq); err != nil {
+		log.Println("Error saving ad targeting in updateAd:", err)
+		return err
+	}
+
+	server.invalidateServableAds(ctx.Request().Context())
+	server.transitionAds(ctx.Request().Context())
+
 	if req.Status == "active" && startDate.After(midnightNow) && startDate.Before(midnightNow.Add(24*time.Hour)) || req.Status == "active" && startDate.Before(midnightNow) {
 		ctx.Response().Header().Add("HX-Trigger", "updateAdSuccess")
 		return server.activeAdsList(ctx)
@@ -686,6 +679,8 @@ func (server *Server) deleteAd(ctx echo.Context) error {
 		return err
 	}
 
+	server.invalidateServableAds(ctx.Request().Context())
+
 	return ctx.NoContent(http.StatusOK)
 }
 
@@ -703,5 +698,8 @@ func (server *Server) deactivateAd(ctx echo.Context) error {
 		return err
 	}
 
+	server.invalidateServableAds(ctx.Request().Context())
+	server.transitionAds(ctx.Request().Context())
+
 	return ctx.NoContent(http.StatusOK)
 }
@@ -0,0 +1,408 @@
+package api
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Advertisers are readers linked to the ads they booked, the portal shows
+// them the reports of those ads and nothing else.
+const (
+	roleUser       = "user"
+	roleAdvertiser = "advertiser"
+)
+
+type AdvertiserReportReq struct {
+	Month string `query:"mesec"`
+}
+
+// advertiserMonth returns the first and last day of the month the report
+// covers, this month unless the advertiser picked another.
+func advertiserMonth(req AdvertiserReportReq) (time.Time, time.Time) {
+	now := time.Now().In(Loc)
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, Loc)
+
+	if t, err := time.ParseInLocation("2006-01", req.Month, Loc); err == nil {
+		from = t
+	}
+
+	return from, from.AddDate(0, 1, -1)
+}
+
+// advertiserDays adds up the stats of every ad per day, days without any
+// are kept so the chart has no gaps.
+func advertiserDays(stats []db.ListAdvertiserDailyStatsRow, from, to time.Time) []components.AdvertiserDay {
+	var days []components.AdvertiserDay
+	index := make(map[string]int)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		index[day.Format("2006-01-02")] = len(days)
+		days = append(days, components.AdvertiserDay{Date: day})
+	}
+
+	for _, stat := range stats {
+		i, ok := index[stat.StatDate.Time.Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+		days[i].Impressions += stat.Impressions
+		days[i].Clicks += stat.Clicks
+	}
+
+	return days
+}
+
+// advertiserReport is the month's report of the signed in advertiser.
+func (server *Server) advertiserReport(ctx echo.Context, req AdvertiserReportReq) (components.AdvertiserReport, error) {
+	from, to := advertiserMonth(req)
+	report := components.AdvertiserReport{Month: from}
+
+	userID := currentUserID(ctx)
+
+	ads, err := server.store.ListAdvertiserAds(ctx.Request().Context(), db.ListAdvertiserAdsParams{
+		StartDate: pgtype.Date{Time: from, Valid: true},
+		EndDate:   pgtype.Date{Time: to, Valid: true},
+		UserID:    userID,
+	})
+	if err != nil {
+		log.Println("Error listing advertiser ads in advertiserReport:", err)
+		return report, err
+	}
+
+	stats, err := server.store.ListAdvertiserDailyStats(ctx.Request().Context(), db.ListAdvertiserDailyStatsParams{
+		UserID:    userID,
+		StartDate: pgtype.Date{Time: from, Valid: true},
+		EndDate:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error listing advertiser daily stats in advertiserReport:", err)
+		return report, err
+	}
+
+	report.Ads = ads
+	report.Days = advertiserDays(stats, from, to)
+
+	return report, nil
+}
+
+// advertiserPortal is the read-only page where advertisers follow their
+// campaigns.
+func (server *Server) advertiserPortal(ctx echo.Context) error {
+	var req AdvertiserReportReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in advertiserPortal:", err)
+		return err
+	}
+
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in advertiserPortal:", err)
+	}
+
+	report, err := server.advertiserReport(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	meta := components.Meta{
+		Title:       "Mačva Press | Oglašavanje",
+		Description: "Izveštaji o oglašavanju",
+		Canonical:   BaseUrl + "/oglasivac",
+		OpenGraph: components.OpenGraphMeta{
+			Title:       "Mačva Press | Oglašavanje",
+			Description: "Izveštaji o oglašavanju",
+			URL:         BaseUrl + "/oglasivac",
+			Type:        "website",
+			Image:       BaseUrl + "/static/assets/macva-1-300x71.png",
+		},
+		Twitter: components.TwitterCardMeta{
+			Card:        "summary_large_image",
+			Title:       "Mačva Press | Oglašavanje",
+			Description: "Izveštaji o oglašavanju",
+			Image:       BaseUrl + "/static/assets/macva-1-300x71.png",
+			Creator:     "@MacvaNews",
+		},
+	}
+
+	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
+	if err != nil {
+		log.Println("Error listing categories in advertiserPortal:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdvertiserPage(userData, meta, categories, report))
+}
+
+func advertiserStatementName(month time.Time, ext string) string {
+	return fmt.Sprintf("izvestaj-oglasavanje-%s.%s", month.Format("2006-01"), ext)
+}
+
+// advertiserStatementCSV downloads the month as CSV, one row per ad,
+// placement and day.
+func (server *Server) advertiserStatementCSV(ctx echo.Context) error {
+	var req AdvertiserReportReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in advertiserStatementCSV:", err)
+		return err
+	}
+
+	from, to := advertiserMonth(req)
+
+	stats, err := server.store.ListAdvertiserDailyStats(ctx.Request().Context(), db.ListAdvertiserDailyStatsParams{
+		UserID:    currentUserID(ctx),
+		StartDate: pgtype.Date{Time: from, Valid: true},
+		EndDate:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error listing advertiser daily stats in advertiserStatementCSV:", err)
+		return err
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", advertiserStatementName(from, "csv")))
+	res.WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(res)
+	w.Write([]string{"datum", "oglas_id", "oglas", "pozicija", "prikazi", "klikovi", "ctr"})
+	for _, stat := range stats {
+		w.Write([]string{
+			stat.StatDate.Time.Format("2006-01-02"),
+			stat.ID.String(),
+			stat.Title.String,
+			stat.Placement,
+			strconv.Itoa(int(stat.Impressions)),
+			strconv.Itoa(int(stat.Clicks)),
+			components.AdCTR(stat.Impressions, stat.Clicks),
+		})
+	}
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		log.Println("Error writing csv in advertiserStatementCSV:", err)
+		return err
+	}
+
+	return nil
+}
+
+// advertiserStatementPDF downloads the month as a printable statement, the
+// campaigns' totals followed by the days.
+func (server *Server) advertiserStatementPDF(ctx echo.Context) error {
+	var req AdvertiserReportReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in advertiserStatementPDF:", err)
+		return err
+	}
+
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in advertiserStatementPDF:", err)
+		return err
+	}
+
+	report, err := server.advertiserReport(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	pdf := utils.NewPDF()
+	pdf.Line(utils.PDFBold, 18, "Mačva Press")
+	pdf.Line(utils.PDFBold, 13, "Izveštaj o oglašavanju, "+components.AdvertiserMonth(report.Month))
+	pdf.Space(6)
+	pdf.Line(utils.PDFRegular, 10, fmt.Sprintf("Oglašivač: %s <%s>", userData.Username, userData.Email))
+	pdf.Line(utils.PDFRegular, 10, "Izdato: "+time.Now().In(Loc).Format("02.01.2006. 15:04"))
+	pdf.Line(utils.PDFRegular, 10, "Prikaz se broji jednom dnevno po posetiocu, botovi se ne broje.")
+
+	pdf.Space(12)
+	pdf.Line(utils.PDFBold, 12, "Kampanje")
+	pdf.Line(utils.PDFMono, 8, fmt.Sprintf("%-32s %-12s %-23s %9s %8s %7s", "Oglas", "Pozicija", "Trajanje", "Prikazi", "Klikovi", "CTR"))
+	for _, ad := range report.Ads {
+		pdf.Line(utils.PDFMono, 8, fmt.Sprintf("%-32s %-12s %-23s %9d %8d %7s",
+			advertiserPDFCell(ad.Title.String, 32),
+			advertiserPDFCell(ad.Placement.String, 12),
+			components.AdvertiserFlight(ad),
+			ad.Impressions, ad.Clicks, components.AdCTR(ad.Impressions, ad.Clicks),
+		))
+	}
+	total := components.AdvertiserTotal(report.Ads)
+	pdf.Line(utils.PDFMono, 8, fmt.Sprintf("%-32s %-12s %-23s %9d %8d %7s",
+		"Ukupno", "", "", total.Impressions, total.Clicks, components.AdCTR(total.Impressions, total.Clicks),
+	))
+
+	pdf.Space(12)
+	pdf.Line(utils.PDFBold, 12, "Po danima")
+	pdf.Line(utils.PDFMono, 8, fmt.Sprintf("%-12s %9s %8s %7s", "Datum", "Prikazi", "Klikovi", "CTR"))
+	for _, day := range report.Days {
+		pdf.Line(utils.PDFMono, 8, fmt.Sprintf("%-12s %9d %8d %7s",
+			day.Date.Format("02.01.2006."), day.Impressions, day.Clicks, components.AdCTR(day.Impressions, day.Clicks),
+		))
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", advertiserStatementName(report.Month, "pdf")))
+	return ctx.Blob(http.StatusOK, "application/pdf", pdf.Bytes())
+}
+
+// advertiserPDFCell cuts text to the width of a statement's column, spelled
+// the way the PDF prints it so the columns line up.
+func advertiserPDFCell(text string, width int) string {
+	runes := []rune(utils.PlainLatin(strings.TrimSpace(text)))
+	if len(runes) > width {
+		return string(runes[:width-1]) + "…"
+	}
+	return string(runes)
+}
+
+// adAdvertisers lists who sees the reports of an ad, in its edit modal.
+func (server *Server) adAdvertisers(ctx echo.Context) error {
+	adID, err := utils.ParseUUID(ctx.Param("id"), "ad ID")
+	if err != nil {
+		log.Println("Error parsing ad ID in adAdvertisers:", err)
+		return err
+	}
+
+	return server.renderAdAdvertisers(ctx, adID, "")
+}
+
+func (server *Server) renderAdAdvertisers(ctx echo.Context, adID pgtype.UUID, advertiserErr string) error {
+	advertisers, err := server.store.ListAdAdvertisers(ctx.Request().Context(), adID)
+	if err != nil {
+		log.Println("Error listing ad advertisers in renderAdAdvertisers:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdAdvertisers(adID.String(), advertisers, advertiserErr))
+}
+
+type AdAdvertiserReq struct {
+	Email string `form:"email" validate:"required,email"`
+}
+
+// addAdAdvertiser links a reader's account to an ad, making them an
+// advertiser. Staff accounts keep their role and can't be linked.
+func (server *Server) addAdAdvertiser(ctx echo.Context) error {
+	var req AdAdvertiserReq
+
+	adID, err := utils.ParseUUID(ctx.Param("id"), "ad ID")
+	if err != nil {
+		log.Println("Error parsing ad ID in addAdAdvertiser:", err)
+		return err
+	}
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in addAdAdvertiser:", err)
+		return err
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		return server.renderAdAdvertisers(ctx, adID, "Unesite ispravnu e-poštu.")
+	}
+
+	user, err := server.store.GetUserByEmail(ctx.Request().Context(), strings.TrimSpace(req.Email))
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && user.IsDeleted.Bool) {
+		return server.renderAdAdvertisers(ctx, adID, "Korisnik sa ovom e-poštom ne postoji.")
+	} else if err != nil {
+		log.Println("Error getting user in addAdAdvertiser:", err)
+		return err
+	}
+
+	if user.Role != roleUser && user.Role != roleAdvertiser {
+		return server.renderAdAdvertisers(ctx, adID, "Administratori i urednici ne mogu biti oglašivači.")
+	}
+
+	if user.Role == roleUser {
+		if err := server.setUserRole(ctx, user.UserID, roleAdvertiser); err != nil {
+			return err
+		}
+	}
+
+	err = server.store.AddAdAdvertiser(ctx.Request().Context(), db.AddAdAdvertiserParams{
+		AdID:   adID,
+		UserID: user.UserID,
+	})
+	if err != nil {
+		log.Println("Error adding ad advertiser in addAdAdvertiser:", err)
+		return err
+	}
+
+	return server.renderAdAdvertisers(ctx, adID, "")
+}
+
+// removeAdAdvertiser unlinks an advertiser from an ad, one left without ads
+// is a reader again.
+func (server *Server) removeAdAdvertiser(ctx echo.Context) error {
+	adID, err := utils.ParseUUID(ctx.Param("id"), "ad ID")
+	if err != nil {
+		log.Println("Error parsing ad ID in removeAdAdvertiser:", err)
+		return err
+	}
+
+	userID, err := utils.ParseUUID(ctx.Param("user_id"), "user ID")
+	if err != nil {
+		log.Println("Error parsing user ID in removeAdAdvertiser:", err)
+		return err
+	}
+
+	err = server.store.RemoveAdAdvertiser(ctx.Request().Context(), db.RemoveAdAdvertiserParams{
+		AdID:   adID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Println("Error removing ad advertiser in removeAdAdvertiser:", err)
+		return err
+	}
+
+	count, err := server.store.CountAdvertiserAds(ctx.Request().Context(), userID)
+	if err != nil {
+		log.Println("Error counting advertiser ads in removeAdAdvertiser:", err)
+		return err
+	}
+
+	user, err := server.store.GetUserByID(ctx.Request().Context(), userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		log.Println("Error getting user in removeAdAdvertiser:", err)
+		return err
+	}
+
+	if count == 0 && user.Role == roleAdvertiser {
+		if err := server.setUserRole(ctx, userID, roleUser); err != nil {
+			return err
+		}
+	}
+
+	return server.renderAdAdvertisers(ctx, adID, "")
+}
+
+// setUserRole changes a user's role, the middlewares read it from the
+// database so it holds from their next request.
+func (server *Server) setUserRole(ctx echo.Context, userID pgtype.UUID, role string) error {
+	err := server.store.SetUserRole(ctx.Request().Context(), db.SetUserRoleParams{
+		UserID: userID,
+		Role:   role,
+	})
+	if err != nil {
+		log.Println("Error setting user role in setUserRole:", err)
+		return err
+	}
+
+	if err := server.cacheService.InvalidateTags(ctx.Request().Context(), userCacheTag(userID)); err != nil {
+		log.Println("Error invalidating cache in setUserRole:", err)
+	}
+
+	return nil
+}
@@ -442,6 +442,8 @@ func (server *Server) listTrendingContentUser(ctx echo.Context) error {
 
 				return pgtype.Text{String: ThumbnailURL, Valid: true}
 			}(),
+			ThumbnailFocalX:     content.ThumbnailFocalX,
+			ThumbnailFocalY:     content.ThumbnailFocalY,
 			ContentDescription:  content.ContentDescription,
 			CommentsEnabled:     content.CommentsEnabled,
 			ViewCountEnabled:    content.ViewCountEnabled,
@@ -456,15 +458,17 @@ func (server *Server) listTrendingContentUser(ctx echo.Context) error {
 			UpdatedAt:           content.UpdatedAt,
 			PublishedAt:         content.PublishedAt,
 			IsDeleted:           content.IsDeleted,
+			IsSponsored:         content.IsSponsored,
 			TotalInteractions:   content.TotalInteractions,
 		})
 	}
 
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error getting global settings in listTrendingContent:", err)
+		log.Println("Error getting engagement policy in listTrendingContent:", err)
 		return err
 	}
+	policy.applyContentData(trendingContent)
 
 	url := "/api/content/popular?limit="
 
@@ -472,5 +476,5 @@ func (server *Server) listTrendingContentUser(ctx echo.Context) error {
 
 	title := "Популарно"
 
-	return Render(ctx, http.StatusOK, components.GridCards(trendingContent, globalSettings[0], int(nextLimit), url, target, title))
+	return Render(ctx, http.StatusOK, components.GridCards(trendingContent, policy.global, int(nextLimit), url, target, title))
 }
@@ -94,6 +94,8 @@ func (server *Server) createCategory(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), pageCategoriesTag)
+
 	ctx.Response().Header().Set("HX-Trigger", `{"categoriesUpdated": ""}`)
 	return ctx.NoContent(http.StatusOK)
 }
@@ -112,6 +114,8 @@ func (server *Server) deleteCategory(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), pageCategoriesTag)
+
 	return server.listCats(ctx)
 }
 
@@ -192,6 +196,8 @@ func (server *Server) updateCategory(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), pageCategoriesTag)
+
 	ctx.Response().Header().Set("HX-Trigger", `{"categoriesUpdated": ""}`)
 	return ctx.NoContent(http.StatusOK)
 }
@@ -268,6 +274,8 @@ func (server *Server) GenerateRecentCatContentComponent(ctx echo.Context) (templ
 
 				return pgtype.Text{String: ThumbnailURL, Valid: true}
 			}(),
+			ThumbnailFocalX:     item.ThumbnailFocalX,
+			ThumbnailFocalY:     item.ThumbnailFocalY,
 			ContentDescription:  item.ContentDescription,
 			CommentsEnabled:     item.CommentsEnabled,
 			ViewCountEnabled:    item.ViewCountEnabled,
@@ -282,16 +290,18 @@ func (server *Server) GenerateRecentCatContentComponent(ctx echo.Context) (templ
 			UpdatedAt:           item.UpdatedAt,
 			PublishedAt:         item.PublishedAt,
 			IsDeleted:           item.IsDeleted,
+			IsSponsored:         item.IsSponsored,
 		})
 	}
 
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error getting global settings in listRecentCategoryContent:", err)
+		log.Println("Error getting engagement policy in listRecentCategoryContent:", err)
 		return nil, err
 	}
+	policy.applyContentData(categoryContent)
 
 	title := "Najnovije iz " + category.CategoryName
 
-	return components.RecentCategoryContent(categoryContent, globalSettings[0], int(nextLimit), title), nil
+	return components.RecentCategoryContent(categoryContent, policy.global, int(nextLimit), title), nil
 }
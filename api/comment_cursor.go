@@ -0,0 +1,103 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// commentsPageSize is how many comments or replies one "load more" adds
+const commentsPageSize = 10
+
+// commentCursor points at the last comment of a page, the next page starts
+// right after it. Comments are ordered by (created_at, comment_id) or by
+// (score, comment_id), so only the field of the current order is set.
+type commentCursor struct {
+	CreatedAt *time.Time `json:"t,omitempty"`
+	Score     *int32     `json:"s,omitempty"`
+	CommentID string     `json:"id"`
+}
+
+// encode returns the opaque form used in the "load more" links.
+func (c commentCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// cacheKey identifies the page the cursor starts, the first page is "first".
+func (c *commentCursor) cacheKey() string {
+	if c == nil {
+		return "first"
+	}
+	return c.encode()
+}
+
+// decodeCommentCursor parses a cursor from a "load more" link. An empty
+// string is the first page and returns nil.
+func decodeCommentCursor(s string) (*commentCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comment cursor: %w", err)
+	}
+
+	var cursor commentCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("invalid comment cursor: %w", err)
+	}
+	if cursor.CreatedAt == nil && cursor.Score == nil {
+		return nil, fmt.Errorf("invalid comment cursor: no position")
+	}
+
+	return &cursor, nil
+}
+
+func (c *commentCursor) commentID() (pgtype.UUID, error) {
+	if c == nil {
+		return pgtype.UUID{}, nil
+	}
+	return utils.ParseUUID(c.CommentID, "comment ID")
+}
+
+func (c *commentCursor) createdAt() pgtype.Timestamptz {
+	if c == nil || c.CreatedAt == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *c.CreatedAt, Valid: true}
+}
+
+func (c *commentCursor) score() pgtype.Int4 {
+	if c == nil || c.Score == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *c.Score, Valid: true}
+}
+
+// nextCommentCursor cuts a page fetched with one extra row down to
+// commentsPageSize and returns the cursor of the following page, or "" if
+// this is the last one.
+func nextCommentCursor(comments []db.ListContentCommentsRow, byScore bool) ([]db.ListContentCommentsRow, string) {
+	if len(comments) <= commentsPageSize {
+		return comments, ""
+	}
+
+	comments = comments[:commentsPageSize]
+	last := comments[len(comments)-1]
+
+	cursor := commentCursor{CommentID: last.CommentID.String()}
+	if byScore {
+		cursor.Score = &last.Score
+	} else {
+		cursor.CreatedAt = &last.CreatedAt.Time
+	}
+
+	return comments, cursor.encode()
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// commentCheck is a new or edited comment as the filters see it
+type commentCheck struct {
+	ContentID pgtype.UUID
+	Text      string
+	Settings  db.GlobalSetting
+}
+
+// commentFilter decides whether a comment looks like spam or abuse. It
+// returns why the comment should be held for moderation, or "" to let it
+// through. Filters never reject a comment outright, a moderator decides.
+type commentFilter interface {
+	check(ctx context.Context, comment commentCheck) (string, error)
+}
+
+// defaultCommentFilters is the pipeline every new or edited comment goes through.
+func defaultCommentFilters(store *db.Store) []commentFilter {
+	return []commentFilter{
+		linkFilter{},
+		blocklistFilter{},
+		duplicateFilter{store: store},
+		&spamClassifier{store: store},
+	}
+}
+
+// screenComment runs the comment filters and returns why the comment is held,
+// or "" if it can be published. Admins are never held. A filter that fails
+// is skipped, so a broken filter doesn't stop readers from commenting.
+func (server *Server) screenComment(ctx context.Context, user db.GetUserByIDRow, contentID pgtype.UUID, text string) string {
+	if user.Role == "admin" {
+		return ""
+	}
+
+	globalSettings, err := server.store.GetGlobalSettings(ctx)
+	if err != nil || len(globalSettings) == 0 {
+		log.Println("Error getting global settings in screenComment:", err)
+		return ""
+	}
+
+	comment := commentCheck{
+		ContentID: contentID,
+		Text:      text,
+		Settings:  globalSettings[0],
+	}
+
+	var reasons []string
+	for _, filter := range server.commentFilters {
+		reason, err := filter.check(ctx, comment)
+		if err != nil {
+			log.Printf("Error running comment filter %T in screenComment: %v", filter, err)
+			continue
+		}
+		if reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// linkRegexp matches URLs and bare domains such as "primer.rs"
+var linkRegexp = regexp.MustCompile(`(?i)(?:https?://|www\.)\S+|\b[a-z0-9-]+\.(?:com|net|org|rs|info|biz|ru|xyz|io|me|top|site|online|shop)\b`)
+
+// linkFilter holds comments with more links than the comment settings allow.
+type linkFilter struct{}
+
+func (linkFilter) check(ctx context.Context, comment commentCheck) (string, error) {
+	links := len(linkRegexp.FindAllString(comment.Text, -1))
+	if links > int(comment.Settings.CommentMaxLinks) {
+		return fmt.Sprintf("Previše linkova (%d)", links), nil
+	}
+	return "", nil
+}
+
+// blocklistFilter holds comments containing a word or phrase of the blocklist.
+// Both are normalized first, so a Latin entry also catches its Cyrillic
+// spelling and the other way around.
+type blocklistFilter struct{}
+
+func (blocklistFilter) check(ctx context.Context, comment commentCheck) (string, error) {
+	text := " " + strings.Join(utils.Tokenize(comment.Text), " ") + " "
+
+	for _, line := range strings.Split(comment.Settings.CommentBlocklist, "\n") {
+		entry := strings.Join(utils.Tokenize(line), " ")
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(text, " "+entry+" ") {
+			return fmt.Sprintf("Zabranjena reč: %s", strings.TrimSpace(line)), nil
+		}
+	}
+
+	return "", nil
+}
+
+// duplicateMinLength keeps short comments like "Bravo!" out of the duplicate check
+const duplicateMinLength = 20
+
+// duplicateFilter holds text that was just posted under other articles, the
+// usual pattern of comment spam.
+type duplicateFilter struct {
+	store *db.Store
+}
+
+func (f duplicateFilter) check(ctx context.Context, comment commentCheck) (string, error) {
+	if len([]rune(strings.TrimSpace(comment.Text))) < duplicateMinLength {
+		return "", nil
+	}
+
+	copies, err := f.store.CountDuplicateComments(ctx, db.CountDuplicateCommentsParams{
+		CommentText: comment.Text,
+		ContentID:   comment.ContentID,
+	})
+	if err != nil {
+		return "", err
+	}
+	if copies > 0 {
+		return fmt.Sprintf("Isti tekst na drugim člancima (%d)", copies), nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		maxLinks int32
+		held     bool
+	}{
+		{"no links", "Odličan tekst, hvala.", 0, false},
+		{"one link allowed", "Više na https://primer.rs/vest", 1, false},
+		{"one link too many", "Više na https://primer.rs/vest", 0, true},
+		{"bare domains", "Pogledajte primer.com i drugi.net", 1, true},
+		{"www", "www.primer.org/akcija", 0, true},
+		{"sentence ending", "Kraj rečenice.Nastavak", 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, err := linkFilter{}.check(context.Background(), commentCheck{
+				Text:     tc.text,
+				Settings: db.GlobalSetting{CommentMaxLinks: tc.maxLinks},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.held, reason != "", reason)
+		})
+	}
+}
+
+func TestBlocklistFilter(t *testing.T) {
+	blocklist := "kockanje\nbrza zarada\n\n  "
+
+	testCases := []struct {
+		name string
+		text string
+		held bool
+	}{
+		{"clean", "Lepa vest iz Bogatića.", false},
+		{"word", "Najbolje kockanje na netu", true},
+		{"case", "KOCKANJE!!!", true},
+		{"cyrillic spelling", "Најбоље коцкање", true},
+		{"phrase", "Brza   zarada od kuće", true},
+		{"phrase split", "Brza pomoć, zarada kasnije", false},
+		{"part of a word", "kockanjee nije isto", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, err := blocklistFilter{}.check(context.Background(), commentCheck{
+				Text:     tc.text,
+				Settings: db.GlobalSetting{CommentBlocklist: blocklist},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.held, reason != "", reason)
+		})
+	}
+}
+
+func TestSpamTokens(t *testing.T) {
+	testCases := []struct {
+		name   string
+		text   string
+		tokens []string
+	}{
+		{"empty", "", nil},
+		{"normalized", "Шпијун Špijun spijun", []string{"spijun"}},
+		{"short words dropped", "a b cd", []string{"cd"}},
+		{"punctuation", "Kupi-odmah! www.primer.rs", []string{"kupi", "odmah", "www", "primer", "rs"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.tokens, spamTokens(tc.text))
+		})
+	}
+
+	// Long comments are cut off
+	var words []string
+	for i := 0; i < spamMaxTokens+50; i++ {
+		words = append(words, fmt.Sprintf("rec%d", i))
+	}
+	require.Len(t, spamTokens(strings.Join(words, " ")), spamMaxTokens)
+}
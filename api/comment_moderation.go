@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// heldCommentsLimit caps how many held comments the moderation page shows at once
+const heldCommentsLimit = 100
+
+func (server *Server) adminHeldComments(ctx echo.Context) error {
+	comments, err := server.store.ListHeldComments(ctx.Request().Context(), heldCommentsLimit)
+	if err != nil {
+		log.Println("Error listing held comments in adminHeldComments:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdminHeldComments(comments))
+}
+
+// heldCommentsCount renders the number of held comments next to the moderation link.
+func (server *Server) heldCommentsCount(ctx echo.Context) error {
+	count, err := server.store.CountHeldComments(ctx.Request().Context())
+	if err != nil {
+		log.Println("Error counting held comments in heldCommentsCount:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdminHeldCount(int(count)))
+}
+
+// approveHeldComment publishes a held comment as if it had just been posted.
+func (server *Server) approveHeldComment(ctx echo.Context) error {
+	commentID, err := utils.ParseUUID(ctx.Param("id"), "comment ID")
+	if err != nil {
+		log.Println("Invalid comment ID format in approveHeldComment:", err)
+		return err
+	}
+
+	comment, err := server.store.ApproveComment(ctx.Request().Context(), commentID)
+	if err != nil {
+		log.Println("Error approving comment in approveHeldComment:", err)
+		return err
+	}
+
+	err = server.trainSpamClassifier(ctx.Request().Context(), comment.CommentID, comment.CommentText, false)
+	if err != nil {
+		log.Println("Error training spam classifier in approveHeldComment:", err)
+	}
+
+	err = server.incrementDailyComments(ctx)
+	if err != nil {
+		log.Println(err)
+	}
+
+	err = server.store.IncrementCommentCount(ctx.Request().Context(), comment.ContentID)
+	if err != nil {
+		log.Println("Error incrementing comment count in approveHeldComment:", err)
+		return err
+	}
+
+	server.invalidateCommentCache(ctx.Request().Context(), comment.ContentID, comment.ParentCommentID, comment.CommentID)
+
+	author, err := server.store.GetUserByID(ctx.Request().Context(), comment.UserID)
+	if err != nil {
+		log.Println("Error getting comment author in approveHeldComment:", err)
+	} else {
+		server.notifyCommentRecipients(ctx.Request().Context(), comment, author, comment.ParentCommentID)
+		server.publishApprovedComment(ctx.Request().Context(), comment, author)
+	}
+
+	return ctx.HTML(http.StatusOK, "")
+}
+
+// publishApprovedComment shows an approved comment or reply to the readers of
+// its article, like createComment and createReply do when nothing is held.
+func (server *Server) publishApprovedComment(ctx context.Context, comment db.Comment, author db.GetUserByIDRow) {
+	convertedComment := db.ListContentCommentsRow{
+		CommentID:       comment.CommentID,
+		ContentID:       comment.ContentID,
+		UserID:          comment.UserID,
+		CommentText:     comment.CommentText,
+		Score:           comment.Score,
+		CreatedAt:       comment.CreatedAt,
+		UpdatedAt:       comment.UpdatedAt,
+		IsDeleted:       comment.IsDeleted,
+		ParentCommentID: comment.ParentCommentID,
+		Username:        author.Username,
+		Pfp:             author.Pfp,
+		Role:            author.Role,
+	}
+
+	if !comment.ParentCommentID.Valid {
+		server.publishCommentEvent(ctx, comment.ContentID, "comment", components.LiveNewComment(convertedComment))
+		return
+	}
+
+	ancestors, err := server.commentAncestors(ctx, comment.ParentCommentID)
+	if err != nil {
+		log.Println("Error getting comment ancestors in publishApprovedComment:", err)
+		return
+	}
+
+	maxDepth := server.commentMaxDepth(ctx)
+	server.publishCommentEvent(ctx, comment.ContentID, "reply", components.LiveNewReply(convertedComment, min(len(ancestors), maxDepth), maxDepth))
+}
+
+// rejectHeldComment deletes a held comment and teaches the classifier it was spam.
+func (server *Server) rejectHeldComment(ctx echo.Context) error {
+	commentID, err := utils.ParseUUID(ctx.Param("id"), "comment ID")
+	if err != nil {
+		log.Println("Invalid comment ID format in rejectHeldComment:", err)
+		return err
+	}
+
+	comment, err := server.store.GetCommentByID(ctx.Request().Context(), commentID)
+	if err != nil {
+		log.Println("Error getting comment in rejectHeldComment:", err)
+		return err
+	}
+	if !comment.IsHeld {
+		return echo.NewHTTPError(http.StatusConflict, "Comment is not held")
+	}
+
+	err = server.trainSpamClassifier(ctx.Request().Context(), comment.CommentID, comment.CommentText, true)
+	if err != nil {
+		log.Println("Error training spam classifier in rejectHeldComment:", err)
+	}
+
+	_, err = server.store.DeleteComment(ctx.Request().Context(), commentID)
+	if err != nil {
+		log.Println("Error deleting comment in rejectHeldComment:", err)
+		return err
+	}
+
+	return ctx.HTML(http.StatusOK, "")
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// commentEditWindow returns how long after posting readers may edit a
+// comment, editing is off when the settings can't be read.
+func (server *Server) commentEditWindow(ctx context.Context) time.Duration {
+	globalSettings, err := server.store.GetGlobalSettings(ctx)
+	if err != nil || len(globalSettings) == 0 {
+		log.Println("Error getting global settings in commentEditWindow:", err)
+		return 0
+	}
+
+	return time.Duration(globalSettings[0].CommentEditWindow) * time.Minute
+}
+
+// listCommentRevisions shows moderators every earlier version of a comment.
+func (server *Server) listCommentRevisions(ctx echo.Context) error {
+	commentID, err := utils.ParseUUID(ctx.Param("id"), "comment ID")
+	if err != nil {
+		log.Println("Invalid comment ID format in listCommentRevisions:", err)
+		return err
+	}
+
+	comment, err := server.store.GetCommentByID(ctx.Request().Context(), commentID)
+	if err != nil {
+		log.Println("Error getting comment in listCommentRevisions:", err)
+		return err
+	}
+
+	revisions, err := server.store.ListCommentRevisions(ctx.Request().Context(), commentID)
+	if err != nil {
+		log.Println("Error listing comment revisions in listCommentRevisions:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.CommentRevisions(comment, revisions))
+}
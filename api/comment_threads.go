@@ -0,0 +1,185 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// commentThreadLimit caps how many comments a permalink loads in context
+const commentThreadLimit = 500
+
+// commentMaxDepth returns how deep replies nest, top-level comments are depth 0.
+func (server *Server) commentMaxDepth(ctx context.Context) int {
+	globalSettings, err := server.store.GetGlobalSettings(ctx)
+	if err != nil || len(globalSettings) == 0 {
+		log.Println("Error getting global settings in commentMaxDepth:", err)
+		return 1
+	}
+
+	return int(globalSettings[0].CommentMaxDepth)
+}
+
+// commentAncestors returns the comment and the comments above it, the
+// top-level comment first, so the comment's depth is len-1.
+func (server *Server) commentAncestors(ctx context.Context, commentID pgtype.UUID) ([]db.ListCommentAncestorsRow, error) {
+	ancestors, err := server.store.ListCommentAncestors(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ancestors) == 0 {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Comment not found")
+	}
+
+	return ancestors, nil
+}
+
+// buildCommentTree nests the rows of ListCommentThread, which come with every
+// reply right after the comment it answers.
+func buildCommentTree(rows []db.ListCommentThreadRow) *components.CommentNode {
+	nodes := make(map[pgtype.UUID]*components.CommentNode, len(rows))
+
+	var root *components.CommentNode
+	for _, row := range rows {
+		node := &components.CommentNode{
+			Comment: db.ListContentCommentsRow{
+				CommentID:       row.CommentID,
+				ContentID:       row.ContentID,
+				UserID:          row.UserID,
+				CommentText:     row.CommentText,
+				Score:           row.Score,
+				CreatedAt:       row.CreatedAt,
+				UpdatedAt:       row.UpdatedAt,
+				IsDeleted:       row.IsDeleted,
+				ParentCommentID: row.ParentCommentID,
+				Username:        row.Username,
+				Pfp:             row.Pfp,
+				Role:            row.Role,
+			},
+			Depth:      int(row.Depth),
+			ReplyCount: int(row.ReplyCount),
+		}
+		nodes[row.CommentID] = node
+
+		if root == nil {
+			root = node
+		} else if parent, ok := nodes[row.ParentCommentID]; ok {
+			parent.Replies = append(parent.Replies, node)
+		}
+	}
+
+	return root
+}
+
+// listCommentThread shows the discussion a comment belongs to, from its
+// top-level comment down, with the comment highlighted.
+func (server *Server) listCommentThread(ctx echo.Context) error {
+	commentIDStr := ctx.Param("id")
+	commentID, err := utils.ParseUUID(commentIDStr, "comment ID")
+	if err != nil {
+		log.Println("Invalid comment ID format in listCommentThread:", err)
+		return err
+	}
+
+	ancestors, err := server.commentAncestors(ctx.Request().Context(), commentID)
+	if err != nil {
+		log.Println("Error getting comment ancestors in listCommentThread:", err)
+		return err
+	}
+	contentID := ancestors[0].ContentID
+
+	rows, err := server.store.ListCommentThread(ctx.Request().Context(), db.ListCommentThreadParams{
+		RootCommentID: ancestors[0].CommentID,
+		Limit:         commentThreadLimit,
+	})
+	if err != nil {
+		log.Println("Error listing comment thread in listCommentThread:", err)
+		return err
+	}
+
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in listCommentThread:", err)
+	}
+
+	userReactions, err := server.getUserReactionsForContentWithCache(ctx.Request().Context(), contentID, userData.UserID)
+	if err != nil {
+		log.Println("Error getting user reactions in listCommentThread:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.CommentThread(
+		contentID.String(),
+		buildCommentTree(rows),
+		userData,
+		userReactions,
+		commentIDStr,
+		server.commentMaxDepth(ctx.Request().Context()),
+	))
+}
+
+// commentPermalink sends a comment's permalink to its article, which then
+// loads the comment's thread instead of the newest comments.
+func (server *Server) commentPermalink(ctx echo.Context) error {
+	commentIDStr := ctx.Param("id")
+	commentID, err := utils.ParseUUID(commentIDStr, "comment ID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Comment not found")
+	}
+
+	ancestors, err := server.commentAncestors(ctx.Request().Context(), commentID)
+	if err != nil {
+		log.Println("Error getting comment ancestors in commentPermalink:", err)
+		return err
+	}
+
+	content, err := server.store.GetContentDetails(ctx.Request().Context(), ancestors[0].ContentID)
+	if err != nil {
+		log.Println("Error getting content details in commentPermalink:", err)
+		return err
+	}
+
+	url := fmt.Sprintf("%s?komentar=%s#comment-%s", utils.PrettyURL(content.Slug, content.PublishedAt.Time), commentIDStr, commentIDStr)
+
+	return ctx.Redirect(http.StatusFound, url)
+}
+
+type CommentSettingsReq struct {
+	CommentMaxDepth   int32  `form:"comment_max_depth" validate:"gte=1,lte=10"`
+	CommentMaxLinks   int32  `form:"comment_max_links" validate:"gte=0,lte=20"`
+	CommentBlocklist  string `form:"comment_blocklist" validate:"max=20000"`
+	CommentEditWindow int32  `form:"comment_edit_window" validate:"gte=0,lte=10080"`
+}
+
+func (server *Server) updateCommentSettings(ctx echo.Context) error {
+	var req CommentSettingsReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in updateCommentSettings:", err)
+		return err
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		return Render(ctx, http.StatusOK, components.UpdateError("Dubina odgovora mora biti između 1 i 10, broj linkova između 0 i 20, a rok za izmenu između 0 i 10080 minuta."))
+	}
+
+	err := server.store.UpdateCommentSettings(ctx.Request().Context(), db.UpdateCommentSettingsParams{
+		CommentMaxDepth:   req.CommentMaxDepth,
+		CommentMaxLinks:   req.CommentMaxLinks,
+		CommentBlocklist:  req.CommentBlocklist,
+		CommentEditWindow: req.CommentEditWindow,
+	})
+	if err != nil {
+		log.Println("Error updating comment settings in updateCommentSettings:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.UpdateSuccess("Podešavanja komentara su sačuvana."))
+}
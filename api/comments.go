@@ -4,18 +4,19 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/00mark0/macva-press/components"
 	"github.com/00mark0/macva-press/db/services"
 	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/labstack/echo/v4"
 )
 
 func (server *Server) listContentComments(ctx echo.Context) error {
-	var req ListAdsReq
-
-	if err := ctx.Bind(&req); err != nil {
-		log.Println("Error binding request in listContentComments:", err)
+	cursor, err := decodeCommentCursor(ctx.QueryParam("cursor"))
+	if err != nil {
+		log.Println("Invalid cursor in listContentComments:", err)
 		return err
 	}
 
@@ -37,15 +38,20 @@ func (server *Server) listContentComments(ctx echo.Context) error {
 		return err
 	}
 
-	nextLimit := req.Limit + 10
-
-	comments, err := server.getCommentsWithCache(ctx.Request().Context(), contentID, nextLimit)
+	comments, err := server.getCommentsWithCache(ctx.Request().Context(), contentID, cursor)
 	if err != nil {
 		log.Println("Error listing comments in listContentComments:", err)
 		return err
 	}
+	comments, nextCursor := nextCommentCursor(comments, false)
 
-	url := fmt.Sprintf("/api/content/comments/%s?limit=", contentIDStr)
+	url := fmt.Sprintf("/api/content/comments/%s", contentIDStr)
+
+	// Later pages are appended below the ones already shown
+	if cursor != nil {
+		return Render(ctx, http.StatusOK, components.CommentsPage(comments, userData, userReactions, url, nextCursor))
+	}
+	comments = server.withShadowedComments(ctx.Request().Context(), comments, userData, contentID, pgtype.UUID{})
 
 	commentCount, err := server.getCommentCountWithCache(ctx.Request().Context(), contentID)
 	if err != nil {
@@ -58,17 +64,16 @@ func (server *Server) listContentComments(ctx echo.Context) error {
 		comments,
 		userData,
 		userReactions,
-		int(nextLimit),
 		url,
+		nextCursor,
 		int(commentCount),
 	))
 }
 
 func (server *Server) listContentCommentsScore(ctx echo.Context) error {
-	var req ListAdsReq
-
-	if err := ctx.Bind(&req); err != nil {
-		log.Println("Error binding request in listContentCommentsScore:", err)
+	cursor, err := decodeCommentCursor(ctx.QueryParam("cursor"))
+	if err != nil {
+		log.Println("Invalid cursor in listContentCommentsScore:", err)
 		return err
 	}
 
@@ -90,22 +95,12 @@ func (server *Server) listContentCommentsScore(ctx echo.Context) error {
 		return err
 	}
 
-	nextLimit := req.Limit + 10
-
-	comments, err := server.getCommentsByScoreWithCache(ctx.Request().Context(), contentID, nextLimit)
+	comments, err := server.getCommentsByScoreWithCache(ctx.Request().Context(), contentID, cursor)
 	if err != nil {
 		log.Println("Error listing comments in listContentCommentsScore:", err)
 		return err
 	}
 
-	url := fmt.Sprintf("/api/content/comments/%s/score?limit=", contentIDStr)
-
-	commentCount, err := server.getCommentCountWithCache(ctx.Request().Context(), contentID)
-	if err != nil {
-		log.Println("Error getting comment count in listContentCommentsScore:", err)
-		return err
-	}
-
 	var convertedComments []db.ListContentCommentsRow
 	for _, comment := range comments {
 		convertedComments = append(convertedComments, db.ListContentCommentsRow{
@@ -123,14 +118,29 @@ func (server *Server) listContentCommentsScore(ctx echo.Context) error {
 			Role:            comment.Role,
 		})
 	}
+	convertedComments, nextCursor := nextCommentCursor(convertedComments, true)
+
+	url := fmt.Sprintf("/api/content/comments/%s/score", contentIDStr)
+
+	// Later pages are appended below the ones already shown
+	if cursor != nil {
+		return Render(ctx, http.StatusOK, components.CommentsPage(convertedComments, userData, userReactions, url, nextCursor))
+	}
+	convertedComments = server.withShadowedComments(ctx.Request().Context(), convertedComments, userData, contentID, pgtype.UUID{})
+
+	commentCount, err := server.getCommentCountWithCache(ctx.Request().Context(), contentID)
+	if err != nil {
+		log.Println("Error getting comment count in listContentCommentsScore:", err)
+		return err
+	}
 
 	return Render(ctx, http.StatusOK, components.ArticleComments(
 		contentIDStr,
 		convertedComments,
 		userData,
 		userReactions,
-		int(nextLimit),
 		url,
+		nextCursor,
 		int(commentCount),
 	))
 }
@@ -165,16 +175,52 @@ func (server *Server) createComment(ctx echo.Context) error {
 		log.Println("Error getting user in createComment:", err)
 	}
 
-	_, err = server.store.CreateComment(ctx.Request().Context(), db.CreateCommentParams{
+	sanctions := sanctionsFromContext(ctx)
+	if message := sanctions.muteMessage(); message != "" {
+		ctx.Response().Header().Set("HX-Retarget", "#user-modal")
+		return Render(ctx, http.StatusOK, components.InfoWarning(message))
+	}
+
+	allowed, err := server.articleEngagement(ctx.Request().Context(), contentID)
+	if err != nil {
+		log.Println("Error getting article engagement in createComment:", err)
+		return err
+	}
+	if !allowed.Comments {
+		ctx.Response().Header().Set("HX-Retarget", "#user-modal")
+		return Render(ctx, http.StatusOK, components.InfoWarning(commentsClosedMessage))
+	}
+
+	// No one but the author sees a shadow-banned user's comments, so they skip the filters
+	var heldReason string
+	if sanctions.ShadowBan == nil {
+		heldReason = server.screenComment(ctx.Request().Context(), userData, contentID, req.CommentText)
+	}
+
+	comment, err := server.store.CreateComment(ctx.Request().Context(), db.CreateCommentParams{
 		ContentID:   contentID,
 		UserID:      userData.UserID,
 		CommentText: req.CommentText,
+		IsHeld:      heldReason != "",
+		HeldReason:  heldReason,
+		IsShadowed:  sanctions.ShadowBan != nil,
 	})
 	if err != nil {
 		log.Println("Error creating comment:", err)
 		return err
 	}
 
+	// Held comments are counted and announced once a moderator approves them
+	if comment.IsHeld {
+		ctx.Response().Header().Set("HX-Retarget", "#comment-held-notice")
+		return Render(ctx, http.StatusOK, components.CommentHeldNotice())
+	}
+
+	// Shadowed comments are listed for their author only, nothing is counted or announced
+	if comment.IsShadowed {
+		return server.listContentComments(ctx)
+	}
+
 	err = server.incrementDailyComments(ctx)
 	if err != nil {
 		log.Println(err)
@@ -186,10 +232,21 @@ func (server *Server) createComment(ctx echo.Context) error {
 		return err
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
-	if err != nil {
-		log.Printf("Failed to invalidate comment-related cache: %v", err)
-	}
+	server.invalidateCommentCache(ctx.Request().Context(), contentID, comment.ParentCommentID, comment.CommentID)
+	server.notifyCommentRecipients(ctx.Request().Context(), comment, userData, pgtype.UUID{})
+	server.publishCommentEvent(ctx.Request().Context(), contentID, "comment", components.LiveNewComment(db.ListContentCommentsRow{
+		CommentID:   comment.CommentID,
+		ContentID:   comment.ContentID,
+		UserID:      comment.UserID,
+		CommentText: comment.CommentText,
+		Score:       comment.Score,
+		CreatedAt:   comment.CreatedAt,
+		UpdatedAt:   comment.UpdatedAt,
+		IsDeleted:   comment.IsDeleted,
+		Username:    userData.Username,
+		Pfp:         userData.Pfp,
+		Role:        userData.Role,
+	}))
 
 	return server.listContentComments(ctx)
 }
@@ -260,6 +317,8 @@ func (server *Server) handleUpvoteComment(ctx echo.Context) error {
 		return err
 	}
 
+	server.publishCommentEvent(ctx.Request().Context(), updatedComment.ContentID, "score", components.CommentScore(updatedComment.CommentID, updatedComment.Score))
+
 	// Get the updated user reaction for the response
 	reactionStatus := ""
 	updatedUserReaction, err := server.store.GetUserCommentReaction(ctx.Request().Context(), db.GetUserCommentReactionParams{
@@ -271,10 +330,7 @@ func (server *Server) handleUpvoteComment(ctx echo.Context) error {
 		reactionStatus = updatedUserReaction.Reaction
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
-	if err != nil {
-		log.Printf("Failed to invalidate comment-related cache: %v", err)
-	}
+	server.invalidateCommentCache(ctx.Request().Context(), updatedComment.ContentID, updatedComment.ParentCommentID, updatedComment.CommentID)
 
 	// Render just the comment actions part
 	return Render(ctx, http.StatusOK, components.CommentActions(updatedComment, userData, reactionStatus))
@@ -345,6 +401,8 @@ func (server *Server) handleDownvoteComment(ctx echo.Context) error {
 		return err
 	}
 
+	server.publishCommentEvent(ctx.Request().Context(), updatedComment.ContentID, "score", components.CommentScore(updatedComment.CommentID, updatedComment.Score))
+
 	// Get the updated user reaction for the response
 	reactionStatus := ""
 	updatedUserReaction, err := server.store.GetUserCommentReaction(ctx.Request().Context(), db.GetUserCommentReactionParams{
@@ -356,10 +414,7 @@ func (server *Server) handleDownvoteComment(ctx echo.Context) error {
 		reactionStatus = updatedUserReaction.Reaction
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
-	if err != nil {
-		log.Printf("Failed to invalidate comment-related cache: %v", err)
-	}
+	server.invalidateCommentCache(ctx.Request().Context(), updatedComment.ContentID, updatedComment.ParentCommentID, updatedComment.CommentID)
 
 	// Render just the comment actions part
 	return Render(ctx, http.StatusOK, components.CommentActions(updatedComment, userData, reactionStatus))
@@ -387,6 +442,12 @@ func (server *Server) createReply(ctx echo.Context) error {
 		log.Println("Error getting user in createReply:", err)
 	}
 
+	sanctions := sanctionsFromContext(ctx)
+	if message := sanctions.muteMessage(); message != "" {
+		ctx.Response().Header().Set("HX-Retarget", "#user-modal")
+		return Render(ctx, http.StatusOK, components.InfoWarning(message))
+	}
+
 	parentCommentIDStr := ctx.Param("id")
 	parentCommentID, err := utils.ParseUUID(parentCommentIDStr, "parent comment ID")
 	if err != nil {
@@ -394,12 +455,49 @@ func (server *Server) createReply(ctx echo.Context) error {
 		return err
 	}
 
+	ancestors, err := server.commentAncestors(ctx.Request().Context(), parentCommentID)
+	if err != nil {
+		log.Println("Error getting comment ancestors in createReply:", err)
+		return err
+	}
+
+	// The comment the reply answers, replies at the deepest level answer one
+	// of their siblings and are posted under their shared parent
+	replyToID := parentCommentID
+	replyToReplyIDStr := ctx.FormValue("reply_to_reply")
+	if replyToReplyIDStr != "" {
+		replyToID, err = utils.ParseUUID(replyToReplyIDStr, "reply to reply ID")
+		if err != nil {
+			log.Println("Invalid reply to reply ID format in createReply:", err)
+			return err
+		}
+	}
+
+	// Past the deepest level the reply goes next to the comment it answers,
+	// e.g. when the depth setting was lowered after the page was loaded
+	maxDepth := server.commentMaxDepth(ctx.Request().Context())
+	if len(ancestors) > maxDepth {
+		ancestors = ancestors[:maxDepth]
+		parentCommentID = ancestors[maxDepth-1].CommentID
+		ctx.Response().Header().Set("HX-Retarget", fmt.Sprintf("#comment-replies-%s", parentCommentID.String()))
+	}
+
 	parentComment, err := server.store.GetCommentByID(ctx.Request().Context(), parentCommentID)
 	if err != nil {
 		log.Println("Error getting parent comment in createReply:", err)
 		return err
 	}
 
+	allowed, err := server.articleEngagement(ctx.Request().Context(), parentComment.ContentID)
+	if err != nil {
+		log.Println("Error getting article engagement in createReply:", err)
+		return err
+	}
+	if !allowed.Comments {
+		ctx.Response().Header().Set("HX-Retarget", "#user-modal")
+		return Render(ctx, http.StatusOK, components.InfoWarning(commentsClosedMessage))
+	}
+
 	arg := db.CreateReplyParams{
 		ParentCommentID: parentCommentID,
 		UserID:          userData.UserID,
@@ -407,16 +505,9 @@ func (server *Server) createReply(ctx echo.Context) error {
 		CommentText:     req.ReplyText,
 	}
 
-	replyToReplyIDStr := ctx.FormValue("reply_to_reply")
-
-	if replyToReplyIDStr != "" {
-		replyToReplyID, err := utils.ParseUUID(replyToReplyIDStr, "reply to reply ID")
-		if err != nil {
-			log.Println("Invalid reply to reply ID format in createReply:", err)
-			return err
-		}
-
-		replyToReplyComment, err := server.store.GetCommentByID(ctx.Request().Context(), replyToReplyID)
+	// Answers to a comment other than the parent mention its author
+	if replyToID != parentCommentID {
+		replyToReplyComment, err := server.store.GetCommentByID(ctx.Request().Context(), replyToID)
 		if err != nil {
 			log.Println("Error getting reply to reply comment in createReply:", err)
 			return err
@@ -428,34 +519,29 @@ func (server *Server) createReply(ctx echo.Context) error {
 			return err
 		}
 
-		arg = db.CreateReplyParams{
-			ParentCommentID: parentCommentID,
-			UserID:          userData.UserID,
-			ContentID:       parentComment.ContentID,
-			CommentText:     fmt.Sprintf("@%s %s", replyToReplyCommentUser.Username, req.ReplyText),
-		}
-	}
-
-	comment, err := server.store.CreateReply(ctx.Request().Context(), arg)
-	if err != nil {
-		log.Println("Error creating reply:", err)
-		return err
+		arg.CommentText = fmt.Sprintf("@%s %s", replyToReplyCommentUser.Username, req.ReplyText)
 	}
 
-	err = server.incrementDailyComments(ctx)
-	if err != nil {
-		log.Println(err)
+	if sanctions.ShadowBan != nil {
+		arg.IsShadowed = true
+	} else {
+		heldReason := server.screenComment(ctx.Request().Context(), userData, parentComment.ContentID, arg.CommentText)
+		arg.IsHeld = heldReason != ""
+		arg.HeldReason = heldReason
 	}
 
-	err = server.store.IncrementCommentCount(ctx.Request().Context(), parentComment.ContentID)
+	comment, err := server.store.CreateReply(ctx.Request().Context(), arg)
 	if err != nil {
-		log.Println("Error incrementing comment count in createReply:", err)
+		log.Println("Error creating reply:", err)
 		return err
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
-	if err != nil {
-		log.Printf("Failed to invalidate comment-related cache: %v", err)
+	// Held replies are counted and announced once a moderator approves them,
+	// the notice goes where createComment puts it and not among the replies
+	if comment.IsHeld {
+		ctx.Response().Header().Set("HX-Retarget", "#comment-held-notice")
+		ctx.Response().Header().Set("HX-Reswap", "innerHTML")
+		return Render(ctx, http.StatusOK, components.CommentHeldNotice())
 	}
 
 	convertedComment := db.ListContentCommentsRow{
@@ -473,7 +559,25 @@ func (server *Server) createReply(ctx echo.Context) error {
 		Role:            userData.Role,
 	}
 
-	return Render(ctx, http.StatusOK, components.CommentReplyItem(convertedComment, userData, ""))
+	// Shadowed replies are shown to their author as if they were published
+	if !comment.IsShadowed {
+		err = server.incrementDailyComments(ctx)
+		if err != nil {
+			log.Println(err)
+		}
+
+		err = server.store.IncrementCommentCount(ctx.Request().Context(), parentComment.ContentID)
+		if err != nil {
+			log.Println("Error incrementing comment count in createReply:", err)
+			return err
+		}
+
+		server.invalidateCommentCache(ctx.Request().Context(), comment.ContentID, comment.ParentCommentID, comment.CommentID)
+		server.notifyCommentRecipients(ctx.Request().Context(), comment, userData, replyToID)
+		server.publishCommentEvent(ctx.Request().Context(), comment.ContentID, "reply", components.LiveNewReply(convertedComment, len(ancestors), maxDepth))
+	}
+
+	return Render(ctx, http.StatusOK, components.CommentReplyItem(convertedComment, userData, "", len(ancestors), maxDepth))
 }
 
 func (server *Server) listRepliesInfo(ctx echo.Context) error {
@@ -491,12 +595,10 @@ func (server *Server) listRepliesInfo(ctx echo.Context) error {
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.CommentReplyInfo(int(replyCount), adminPfp, commentIDStr))
+	return Render(ctx, http.StatusOK, components.CommentReplyInfo(int(replyCount), adminPfp, commentIDStr, false))
 }
 
 func (server *Server) listCommentReplies(ctx echo.Context) error {
-	var req ListAdsReq
-
 	parentCommentIDStr := ctx.Param("id")
 	parentCommentID, err := utils.ParseUUID(parentCommentIDStr, "parent comment ID")
 	if err != nil {
@@ -504,14 +606,22 @@ func (server *Server) listCommentReplies(ctx echo.Context) error {
 		return err
 	}
 
-	if err := ctx.Bind(&req); err != nil {
-		log.Println("Error binding request in listCommentReplies:", err)
+	cursor, err := decodeCommentCursor(ctx.QueryParam("cursor"))
+	if err != nil {
+		log.Println("Invalid cursor in listCommentReplies:", err)
 		return err
 	}
 
-	nextLimit := req.Limit + 10
+	ancestors, err := server.commentAncestors(ctx.Request().Context(), parentCommentID)
+	if err != nil {
+		log.Println("Error getting comment ancestors in listCommentReplies:", err)
+		return err
+	}
+	// Replies are one level below their parent
+	depth := len(ancestors)
+	maxDepth := server.commentMaxDepth(ctx.Request().Context())
 
-	replies, err := server.listCommentRepliesWithCache(ctx.Request().Context(), parentCommentID, nextLimit)
+	replies, err := server.listCommentRepliesWithCache(ctx.Request().Context(), parentCommentID, cursor)
 	if err != nil {
 		log.Println("Error listing comment replies:", err)
 		return err
@@ -534,20 +644,28 @@ func (server *Server) listCommentReplies(ctx echo.Context) error {
 			Role:            reply.Role,
 		})
 	}
+	convertedReplies, nextCursor := nextCommentCursor(convertedReplies, false)
 
 	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
 	if err != nil {
 		log.Println("Error getting user in listCommentReplies:", err)
 	}
 
-	userReactions, err := server.getUserReactionsForContentWithCache(ctx.Request().Context(), replies[0].ContentID, userData.UserID)
-	if err != nil {
-		log.Println("Error fetching user reactions:", err)
+	var userReactions map[string]string
+	if len(replies) > 0 {
+		userReactions, err = server.getUserReactionsForContentWithCache(ctx.Request().Context(), replies[0].ContentID, userData.UserID)
+		if err != nil {
+			log.Println("Error fetching user reactions:", err)
+		}
 	}
 
-	url := fmt.Sprintf("/api/comments/%s/more-replies?limit=", parentCommentIDStr)
+	// Later pages are appended below the ones already shown
+	if cursor != nil {
+		return Render(ctx, http.StatusOK, components.CommentReplyPage(convertedReplies, userData, userReactions, parentCommentIDStr, nextCursor, depth, maxDepth))
+	}
+	convertedReplies = server.withShadowedComments(ctx.Request().Context(), convertedReplies, userData, ancestors[0].ContentID, parentCommentID)
 
-	return Render(ctx, http.StatusOK, components.CommentReplyList(convertedReplies, userData, userReactions, int(nextLimit), url))
+	return Render(ctx, http.StatusOK, components.CommentReplyList(convertedReplies, userData, userReactions, parentCommentIDStr, nextCursor, depth, maxDepth))
 }
 
 type UpdateCommentReq struct {
@@ -574,9 +692,40 @@ func (server *Server) updateComment(ctx echo.Context) error {
 		return err
 	}
 
+	comment, err := server.store.GetCommentByID(ctx.Request().Context(), commentID)
+	if err != nil {
+		log.Println("Error getting comment in updateComment:", err)
+		return err
+	}
+
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in updateComment:", err)
+	}
+
+	if comment.UserID != userData.UserID {
+		return echo.NewHTTPError(http.StatusForbidden, "You can only edit your own comments")
+	}
+
+	if message := sanctionsFromContext(ctx).muteMessage(); message != "" {
+		ctx.Response().Header().Set("HX-Retarget", "#user-modal")
+		return Render(ctx, http.StatusOK, components.InfoWarning(message))
+	}
+
+	if time.Since(comment.CreatedAt.Time) > server.commentEditWindow(ctx.Request().Context()) {
+		return Render(ctx, http.StatusOK, components.EditCommentExpired(comment))
+	}
+
+	var heldReason string
+	if !comment.IsShadowed {
+		heldReason = server.screenComment(ctx.Request().Context(), userData, comment.ContentID, req.CommentText)
+	}
+
 	arg := db.UpdateCommentParams{
 		CommentID:   commentID,
 		CommentText: req.CommentText,
+		IsHeld:      heldReason != "",
+		HeldReason:  heldReason,
 	}
 
 	commentData, err := server.store.UpdateComment(ctx.Request().Context(), arg)
@@ -585,12 +734,28 @@ func (server *Server) updateComment(ctx echo.Context) error {
 		return err
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
-	if err != nil {
-		log.Printf("Failed to invalidate comment-related cache: %v", err)
+	server.invalidateCommentCache(ctx.Request().Context(), commentData.ContentID, commentData.ParentCommentID, commentData.CommentID)
+
+	if commentData.IsHeld {
+		// The comment was visible until this edit
+		if !comment.IsHeld {
+			err = server.store.DecrementCommentCount(ctx.Request().Context(), comment.ContentID)
+			if err != nil {
+				log.Println("Error decrementing comment count in updateComment:", err)
+				return err
+			}
+
+			server.publishCommentEvent(ctx.Request().Context(), comment.ContentID, "delete", components.LiveCommentDeleted(comment.CommentID))
+		}
+
+		return Render(ctx, http.StatusOK, components.EditCommentHeld(commentData))
+	}
+
+	if !comment.IsShadowed {
+		server.publishCommentEvent(ctx.Request().Context(), commentData.ContentID, "edit", components.EditCommentResponse(commentData, false))
 	}
 
-	return Render(ctx, http.StatusOK, components.EditCommentResponse(commentData))
+	return Render(ctx, http.StatusOK, components.EditCommentResponse(commentData, userData.Role == "admin"))
 }
 
 func (server *Server) deleteComment(ctx echo.Context) error {
@@ -607,6 +772,11 @@ func (server *Server) deleteComment(ctx echo.Context) error {
 		return err
 	}
 
+	// Held and shadowed comments were never counted
+	if comment.IsHeld || comment.IsShadowed {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
 	err = server.store.DecrementCommentCount(ctx.Request().Context(), comment.ContentID)
 	if err != nil {
 		log.Println("Error decrementing comment count in deleteComment:", err)
@@ -618,10 +788,8 @@ func (server *Server) deleteComment(ctx echo.Context) error {
 		log.Println(err)
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
-	if err != nil {
-		log.Printf("Failed to invalidate comment-related cache: %v", err)
-	}
+	server.invalidateCommentCache(ctx.Request().Context(), comment.ContentID, comment.ParentCommentID, comment.CommentID)
+	server.publishCommentEvent(ctx.Request().Context(), comment.ContentID, "delete", components.LiveCommentDeleted(comment.CommentID))
 
 	return ctx.NoContent(http.StatusNoContent)
 }
@@ -11,9 +11,55 @@ import (
 	redisClient "github.com/redis/go-redis/v9"
 )
 
-func (server *Server) getCommentsWithCache(ctx context.Context, contentID pgtype.UUID, limit int32) ([]db.ListContentCommentsRow, error) {
+// Cache tags. Every cached entry is tagged with what it was built from, so a
+// change only drops the entries that show it.
+func contentCacheTag(contentID pgtype.UUID) string {
+	return redis.GenerateKey("content", contentID)
+}
+
+func commentCacheTag(commentID pgtype.UUID) string {
+	return redis.GenerateKey("comment", commentID)
+}
+
+func userCacheTag(userID pgtype.UUID) string {
+	return redis.GenerateKey("user", userID)
+}
+
+// withAuthorTags adds the tags of the users whose name and picture a list of
+// comments shows, so renaming or banning one of them refreshes the list.
+func withAuthorTags(tags []string, userIDs []pgtype.UUID) []string {
+	seen := make(map[pgtype.UUID]bool)
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		tags = append(tags, userCacheTag(userID))
+	}
+	return tags
+}
+
+// invalidateCommentCache drops the cached lists, counts and reactions a
+// comment shows up in: its article's, its own replies and its parent's replies.
+func (server *Server) invalidateCommentCache(ctx context.Context, contentID, parentCommentID, commentID pgtype.UUID) {
+	tags := []string{contentCacheTag(contentID)}
+	if commentID.Valid {
+		tags = append(tags, commentCacheTag(commentID))
+	}
+	if parentCommentID.Valid {
+		tags = append(tags, commentCacheTag(parentCommentID))
+	}
+
+	if err := server.cacheService.InvalidateTags(ctx, tags...); err != nil {
+		log.Printf("Failed to invalidate comment-related cache: %v", err)
+	}
+}
+
+// getCommentsWithCache returns the page of comments after cursor, newest
+// first, with one extra row that tells whether there's a next page.
+func (server *Server) getCommentsWithCache(ctx context.Context, contentID pgtype.UUID, cursor *commentCursor) ([]db.ListContentCommentsRow, error) {
 	// Generate the cache key
-	cacheKey := redis.GenerateKey("comments", contentID, limit)
+	cacheKey := redis.GenerateKey("comments", contentID, cursor.cacheKey())
 
 	// Try to get comments from cache
 	var comments []db.ListContentCommentsRow
@@ -29,9 +75,15 @@ func (server *Server) getCommentsWithCache(ctx context.Context, contentID pgtype
 
 	// Cache miss, fetch from database
 	log.Printf("Cache miss for comments: %s", cacheKey)
+	afterCommentID, err := cursor.commentID()
+	if err != nil {
+		return nil, err
+	}
 	arg := db.ListContentCommentsParams{
-		ContentID: contentID,
-		Limit:     limit,
+		ContentID:      contentID,
+		AfterCreatedAt: cursor.createdAt(),
+		AfterCommentID: afterCommentID,
+		Limit:          commentsPageSize + 1,
 	}
 	comments, err = server.store.ListContentComments(ctx, arg)
 	if err != nil {
@@ -39,7 +91,12 @@ func (server *Server) getCommentsWithCache(ctx context.Context, contentID pgtype
 	}
 
 	// Store in cache for future use
-	err = server.cacheService.Set(ctx, cacheKey, comments, 10*time.Minute)
+	authors := make([]pgtype.UUID, len(comments))
+	for i, comment := range comments {
+		authors[i] = comment.UserID
+	}
+	tags := withAuthorTags([]string{contentCacheTag(contentID)}, authors)
+	err = server.cacheService.SetWithTags(ctx, cacheKey, comments, 10*time.Minute, tags...)
 	if err != nil {
 		log.Printf("Error caching comments: %v", err)
 	}
@@ -47,9 +104,10 @@ func (server *Server) getCommentsWithCache(ctx context.Context, contentID pgtype
 	return comments, nil
 }
 
-func (server *Server) getCommentsByScoreWithCache(ctx context.Context, contentID pgtype.UUID, limit int32) ([]db.ListContentCommentsByScoreRow, error) {
+// getCommentsByScoreWithCache is getCommentsWithCache ordered by score.
+func (server *Server) getCommentsByScoreWithCache(ctx context.Context, contentID pgtype.UUID, cursor *commentCursor) ([]db.ListContentCommentsByScoreRow, error) {
 	// Generate the cache key
-	cacheKey := redis.GenerateKey("comments_by_score", contentID, limit)
+	cacheKey := redis.GenerateKey("comments_by_score", contentID, cursor.cacheKey())
 
 	// Try to get comments from cache
 	var comments []db.ListContentCommentsByScoreRow
@@ -65,9 +123,15 @@ func (server *Server) getCommentsByScoreWithCache(ctx context.Context, contentID
 
 	// Cache miss, fetch from database
 	log.Printf("Cache miss for comments: %s", cacheKey)
+	afterCommentID, err := cursor.commentID()
+	if err != nil {
+		return nil, err
+	}
 	arg := db.ListContentCommentsByScoreParams{
-		ContentID: contentID,
-		Limit:     limit,
+		ContentID:      contentID,
+		AfterScore:     cursor.score(),
+		AfterCommentID: afterCommentID,
+		Limit:          commentsPageSize + 1,
 	}
 	comments, err = server.store.ListContentCommentsByScore(ctx, arg)
 	if err != nil {
@@ -75,7 +139,12 @@ func (server *Server) getCommentsByScoreWithCache(ctx context.Context, contentID
 	}
 
 	// Store in cache for future use
-	err = server.cacheService.Set(ctx, cacheKey, comments, 10*time.Minute)
+	authors := make([]pgtype.UUID, len(comments))
+	for i, comment := range comments {
+		authors[i] = comment.UserID
+	}
+	tags := withAuthorTags([]string{contentCacheTag(contentID)}, authors)
+	err = server.cacheService.SetWithTags(ctx, cacheKey, comments, 10*time.Minute, tags...)
 	if err != nil {
 		log.Printf("Error caching comments: %v", err)
 	}
@@ -106,7 +175,7 @@ func (server *Server) getReplyCountAndAdminPfp(ctx context.Context, parentCommen
 		}
 
 		// Cache the reply count
-		err = server.cacheService.Set(ctx, countCacheKey, replyCount, 10*time.Minute)
+		err = server.cacheService.SetWithTags(ctx, countCacheKey, replyCount, 10*time.Minute, commentCacheTag(parentCommentID))
 		if err != nil {
 			log.Printf("Error caching reply count for ParentCommentID: %s: %v", parentCommentID, err)
 		}
@@ -145,7 +214,7 @@ func (server *Server) getReplyCountAndAdminPfp(ctx context.Context, parentCommen
 
 		// Cache the checked status
 		hasAdminReply = adminPfp != ""
-		err = server.cacheService.Set(ctx, checkedCacheKey, hasAdminReply, 10*time.Minute)
+		err = server.cacheService.SetWithTags(ctx, checkedCacheKey, hasAdminReply, 10*time.Minute, commentCacheTag(parentCommentID))
 		if err != nil {
 			log.Printf("Error caching admin reply status for ParentCommentID: %s: %v", parentCommentID, err)
 		}
@@ -173,7 +242,8 @@ func scanForAdminPfp(ctx context.Context, server *Server, parentCommentID pgtype
 			adminPfp := reply.Pfp
 
 			// Cache the admin pfp
-			err := server.cacheService.Set(ctx, adminPfpCacheKey, adminPfp, 10*time.Minute)
+			err := server.cacheService.SetWithTags(ctx, adminPfpCacheKey, adminPfp, 10*time.Minute,
+				commentCacheTag(parentCommentID), userCacheTag(reply.UserID))
 			if err != nil {
 				log.Printf("Error caching admin pfp for ParentCommentID: %s: %v", parentCommentID, err)
 			}
@@ -211,7 +281,7 @@ func (server *Server) getUserReactionsForContentWithCache(ctx context.Context, c
 		userReactions[reaction.CommentID.String()] = reaction.Reaction
 	}
 
-	err = server.cacheService.Set(ctx, cacheKey, userReactions, 10*time.Minute)
+	err = server.cacheService.SetWithTags(ctx, cacheKey, userReactions, 10*time.Minute, contentCacheTag(contentID))
 	if err != nil {
 		log.Printf("Error caching user reactions: %v", err)
 	}
@@ -237,7 +307,7 @@ func (server *Server) getCommentCountWithCache(ctx context.Context, contentID pg
 		return 0, err
 	}
 
-	err = server.cacheService.Set(ctx, cacheKey, count, 10*time.Minute)
+	err = server.cacheService.SetWithTags(ctx, cacheKey, count, 10*time.Minute, contentCacheTag(contentID))
 	if err != nil {
 		log.Printf("Error caching comment count: %v", err)
 	}
@@ -245,8 +315,10 @@ func (server *Server) getCommentCountWithCache(ctx context.Context, contentID pg
 	return count, nil
 }
 
-func (server *Server) listCommentRepliesWithCache(ctx context.Context, parentCommentID pgtype.UUID, limit int32) ([]db.ListCommentRepliesRow, error) {
-	cacheKey := redis.GenerateKey("comments_replies", parentCommentID, limit)
+// listCommentRepliesWithCache returns the page of replies after cursor,
+// oldest first, with one extra row that tells whether there's a next page.
+func (server *Server) listCommentRepliesWithCache(ctx context.Context, parentCommentID pgtype.UUID, cursor *commentCursor) ([]db.ListCommentRepliesRow, error) {
+	cacheKey := redis.GenerateKey("comments_replies", parentCommentID, cursor.cacheKey())
 
 	var replies []db.ListCommentRepliesRow
 	cacheHit, err := server.cacheService.Get(ctx, cacheKey, &replies)
@@ -258,15 +330,26 @@ func (server *Server) listCommentRepliesWithCache(ctx context.Context, parentCom
 	}
 
 	log.Printf("Cache miss for listing comment replies: %s", cacheKey)
+	afterCommentID, err := cursor.commentID()
+	if err != nil {
+		return nil, err
+	}
 	replies, err = server.store.ListCommentReplies(ctx, db.ListCommentRepliesParams{
 		ParentCommentID: parentCommentID,
-		Limit:           limit,
+		AfterCreatedAt:  cursor.createdAt(),
+		AfterCommentID:  afterCommentID,
+		Limit:           commentsPageSize + 1,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = server.cacheService.Set(ctx, cacheKey, replies, 10*time.Minute)
+	authors := make([]pgtype.UUID, len(replies))
+	for i, reply := range replies {
+		authors[i] = reply.UserID
+	}
+	tags := withAuthorTags([]string{commentCacheTag(parentCommentID)}, authors)
+	err = server.cacheService.SetWithTags(ctx, cacheKey, replies, 10*time.Minute, tags...)
 	if err != nil {
 		log.Printf("Error caching comment replies: %v", err)
 	}
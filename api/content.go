@@ -58,6 +58,7 @@ func (server *Server) listPubContent(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -105,6 +106,7 @@ func (server *Server) listPubContentOldest(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -152,6 +154,7 @@ func (server *Server) listPubContentTitle(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -199,6 +202,7 @@ func (server *Server) listDraftContent(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -246,6 +250,7 @@ func (server *Server) listDraftContentOldest(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -293,6 +298,7 @@ func (server *Server) listDraftContentTitle(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -340,6 +346,7 @@ func (server *Server) listDelContent(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -387,6 +394,7 @@ func (server *Server) listDelContentOldest(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -434,6 +442,7 @@ func (server *Server) listDelContentTitle(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -496,6 +505,7 @@ func (server *Server) listSearchPubContent(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -553,6 +563,7 @@ func (server *Server) listSearchDraftContent(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -610,6 +621,7 @@ func (server *Server) listSearchDelContent(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -634,6 +646,8 @@ func (server *Server) archivePubContent(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), server.contentPageTags(ctx.Request().Context(), pgUUID)...)
+
 	overview, err := server.store.GetContentOverview(ctx.Request().Context())
 	if err != nil {
 		log.Println("Error getting content overview in archivePubContent:", err)
@@ -670,12 +684,17 @@ func (server *Server) deleteContent(ctx echo.Context) error {
 		}
 	}
 
+	// Gone after the delete, so collect them first
+	pageTags := server.contentPageTags(ctx.Request().Context(), pgUUID)
+
 	_, err = server.store.HardDeleteContent(ctx.Request().Context(), pgUUID)
 	if err != nil {
 		log.Println("Error deleting content in deleteContent:", err)
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), pageTags...)
+
 	overview, err := server.store.GetContentOverview(ctx.Request().Context())
 	if err != nil {
 		log.Println("Error getting content overview in deleteContent:", err)
@@ -699,6 +718,8 @@ func (server *Server) publishDraftContent(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), server.contentPageTags(ctx.Request().Context(), pgUUID)...)
+
 	overview, err := server.store.GetContentOverview(ctx.Request().Context())
 	if err != nil {
 		log.Println("Error getting content overview in publishDraftContent:", err)
@@ -722,6 +743,8 @@ func (server *Server) unarchiveContent(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), server.contentPageTags(ctx.Request().Context(), pgUUID)...)
+
 	overview, err := server.store.GetContentOverview(ctx.Request().Context())
 	if err != nil {
 		log.Println("Error getting content overview in unarchiveContent:", err)
@@ -854,12 +877,17 @@ func (server *Server) updateContent(ctx echo.Context) error {
 		return Render(ctx, http.StatusOK, components.ArticleError(message))
 	}
 
+	// The article may move to another category, purge the old one as well
+	pageTags := server.contentPageTags(ctx.Request().Context(), contentID)
+
 	_, err = server.store.UpdateContent(ctx.Request().Context(), arg)
 	if err != nil {
 		log.Println("Error updating content in updateContent:", err)
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), append(pageTags, pageCategoryTag(arg.CategoryID))...)
+
 	message := "Sadržaj uspešno ažuriran."
 	return Render(ctx, http.StatusOK, components.ArticleSuccess(message))
 }
@@ -869,6 +897,9 @@ type CreateContentReq struct {
 	Title              string `form:"title"`
 	ContentDescription string `form:"content_description"`
 	Slug               string `form:"slug"`
+	IsSponsored        bool   `form:"is_sponsored"`
+	SponsorName        string `form:"sponsor_name"`
+	SponsorLogo        string `form:"sponsor_logo"`
 }
 
 func (server *Server) createContent(ctx echo.Context) error {
@@ -897,6 +928,11 @@ func (server *Server) createContent(ctx echo.Context) error {
 		return Render(ctx, http.StatusOK, components.ArticleError(message))
 	}
 
+	sponsor := SponsorReq{IsSponsored: req.IsSponsored, SponsorName: req.SponsorName, SponsorLogo: req.SponsorLogo}
+	if _, message := sponsorParams(pgtype.UUID{}, sponsor); message != "" {
+		return Render(ctx, http.StatusOK, components.ArticleError(message))
+	}
+
 	userData, err := server.getUserFromCacheOrDb(ctx, "access_token")
 	if err != nil {
 		log.Println("Error getting user data in createContent:", err)
@@ -932,6 +968,14 @@ func (server *Server) createContent(ctx echo.Context) error {
 		return Render(ctx, http.StatusInternalServerError, components.ArticleError(message))
 	}
 
+	if req.IsSponsored {
+		sponsorArg, _ := sponsorParams(content.ContentID, sponsor)
+		if err := server.store.UpdateContentSponsor(ctx.Request().Context(), sponsorArg); err != nil {
+			log.Println("Error updating content sponsor in createContent:", err)
+			return err
+		}
+	}
+
 	ctx.SetCookie(&http.Cookie{
 		Name:     "content_id",
 		Value:    content.ContentID.String(),
@@ -974,6 +1018,11 @@ func (server *Server) createAndPublishContent(ctx echo.Context) error {
 		return Render(ctx, http.StatusOK, components.ArticleError(message))
 	}
 
+	sponsor := SponsorReq{IsSponsored: req.IsSponsored, SponsorName: req.SponsorName, SponsorLogo: req.SponsorLogo}
+	if _, message := sponsorParams(pgtype.UUID{}, sponsor); message != "" {
+		return Render(ctx, http.StatusOK, components.ArticleError(message))
+	}
+
 	userData, err := server.getUserFromCacheOrDb(ctx, "access_token")
 	if err != nil {
 		log.Println("Error getting user data in createAndPublishContent:", err)
@@ -1009,6 +1058,14 @@ func (server *Server) createAndPublishContent(ctx echo.Context) error {
 		return Render(ctx, http.StatusInternalServerError, components.ArticleError(message))
 	}
 
+	if req.IsSponsored {
+		sponsorArg, _ := sponsorParams(content.ContentID, sponsor)
+		if err := server.store.UpdateContentSponsor(ctx.Request().Context(), sponsorArg); err != nil {
+			log.Println("Error updating content sponsor in createAndPublishContent:", err)
+			return err
+		}
+	}
+
 	_, err = server.store.PublishContent(ctx.Request().Context(), content.ContentID)
 	if err != nil {
 		message := "Greška prilikom objavljivanja sadržaja."
@@ -1016,6 +1073,8 @@ func (server *Server) createAndPublishContent(ctx echo.Context) error {
 		return Render(ctx, http.StatusInternalServerError, components.ArticleError(message))
 	}
 
+	server.purgePages(ctx.Request().Context(), server.contentPageTags(ctx.Request().Context(), content.ContentID)...)
+
 	ctx.SetCookie(&http.Cookie{
 		Name:     "content_id",
 		Value:    content.ContentID.String(),
@@ -1070,13 +1129,18 @@ func (server *Server) loadMoreSearch(ctx echo.Context) error {
 		}
 	}
 
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error getting global settings in loadMoreSearch:", err)
+		log.Println("Error getting engagement policy in loadMoreSearch:", err)
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.SearchResults(searchResults, searchCount, req.SearchTerm, int(nextLimit), globalSettings[0]))
+	for i := range searchResults {
+		v := &searchResults[i]
+		policy.apply(v.ContentID, v.CategoryID, v.PublishedAt, &v.CommentsEnabled, &v.LikeCountEnabled, &v.DislikeCountEnabled, &v.ViewCountEnabled)
+	}
+
+	return Render(ctx, http.StatusOK, components.SearchResults(searchResults, searchCount, req.SearchTerm, int(nextLimit), policy.global))
 }
 
 func (server *Server) listOtherContent(ctx echo.Context) error {
@@ -1102,6 +1166,16 @@ func (server *Server) listOtherContent(ctx echo.Context) error {
 		data = []db.ListPublishedContentLimitRow{}
 	}
 
+	policy, err := server.engagementPolicy(ctx.Request().Context())
+	if err != nil {
+		log.Println("Error getting engagement policy in listPubContent:", err)
+		return err
+	}
+	for i := range data {
+		v := &data[i]
+		policy.apply(v.ContentID, v.CategoryID, v.PublishedAt, &v.CommentsEnabled, &v.LikeCountEnabled, &v.DislikeCountEnabled, &v.ViewCountEnabled)
+	}
+
 	var content []components.ListPublishedContentRes
 	for _, v := range data {
 		content = append(content, components.ListPublishedContentRes{
@@ -1117,6 +1191,8 @@ func (server *Server) listOtherContent(ctx echo.Context) error {
 
 				return ThumbnailURL
 			}(),
+			ThumbnailFocalX:     v.ThumbnailFocalX,
+			ThumbnailFocalY:     v.ThumbnailFocalY,
 			ContentDescription:  v.ContentDescription,
 			CommentsEnabled:     v.CommentsEnabled,
 			ViewCountEnabled:    v.ViewCountEnabled,
@@ -1132,18 +1208,13 @@ func (server *Server) listOtherContent(ctx echo.Context) error {
 			PublishedAt:         utils.TimeAgo(v.PublishedAt.Time.In(Loc)),
 			PublishedAtPgType:   v.PublishedAt,
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
 	}
 
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
-	if err != nil {
-		log.Println("Error getting global settings in listPubContent:", err)
-		return err
-	}
-
-	return Render(ctx, http.StatusOK, components.OtherContent(content, int(nextLimit), globalSettings[0]))
+	return Render(ctx, http.StatusOK, components.OtherContent(content, int(nextLimit), policy.global))
 }
 
 type CategoryWithContent struct {
@@ -1159,14 +1230,19 @@ func (server *Server) GenerateNewsSliderComponent(ctx context.Context) (templ.Co
 		return nil, err
 	}
 
-	globalSettings, err := server.store.GetGlobalSettings(ctx)
+	policy, err := server.engagementPolicy(ctx)
 	if err != nil {
-		log.Println("Error getting global settings in newsSlider:", err)
+		log.Println("Error getting engagement policy in newsSlider:", err)
 		return nil, err
 	}
 
+	for i := range content {
+		v := &content[i]
+		policy.apply(v.ContentID, v.CategoryID, v.PublishedAt, &v.CommentsEnabled, &v.LikeCountEnabled, &v.DislikeCountEnabled, &v.ViewCountEnabled)
+	}
+
 	// Return the component
-	return components.NewsSlider(content, globalSettings[0]), nil
+	return components.NewsSlider(content, policy.global), nil
 }
 
 func (server *Server) newsSlider(ctx echo.Context) error {
@@ -1235,6 +1311,8 @@ func (server *Server) categoriesWithContent(ctx echo.Context) error {
 
 					return pgtype.Text{String: ThumbnailURL, Valid: true}
 				}(),
+				ThumbnailFocalX:     item.ThumbnailFocalX,
+				ThumbnailFocalY:     item.ThumbnailFocalY,
 				ContentDescription:  item.ContentDescription,
 				CommentsEnabled:     item.CommentsEnabled,
 				ViewCountEnabled:    item.ViewCountEnabled,
@@ -1249,6 +1327,7 @@ func (server *Server) categoriesWithContent(ctx echo.Context) error {
 				UpdatedAt:           item.UpdatedAt,
 				PublishedAt:         item.PublishedAt,
 				IsDeleted:           item.IsDeleted,
+				IsSponsored:         item.IsSponsored,
 			})
 		}
 
@@ -1265,15 +1344,17 @@ func (server *Server) categoriesWithContent(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusNoContent)
 	}
 
-	// Get global settings
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error getting global settings in categoriesWithContent:", err)
+		log.Println("Error getting engagement policy in categoriesWithContent:", err)
 		return err
 	}
+	for _, categoryContent := range allCategoryContent {
+		policy.applyContentData(categoryContent.Content)
+	}
 
 	// Render the template with all category content
-	return Render(ctx, http.StatusOK, components.CategoriesWithContent(allCategoryContent, globalSettings[0]))
+	return Render(ctx, http.StatusOK, components.CategoriesWithContent(allCategoryContent, policy.global))
 }
 
 func (server *Server) handleLikeContent(ctx echo.Context) error {
@@ -1289,6 +1370,16 @@ func (server *Server) handleLikeContent(ctx echo.Context) error {
 		log.Println("Error getting user data in handleLikeContent:", err)
 	}
 
+	allowed, err := server.articleEngagement(ctx.Request().Context(), articleID)
+	if err != nil {
+		log.Println("Error getting article engagement in handleLikeContent:", err)
+		return err
+	}
+	if !allowed.Likes {
+		ctx.Response().Header().Set("HX-Retarget", "#user-modal")
+		return Render(ctx, http.StatusOK, components.InfoWarning("Sviđanja su isključena za ovaj članak."))
+	}
+
 	// Check if the user already has a reaction using the efficient query
 	userReaction, err := server.store.FetchUserContentReaction(ctx.Request().Context(), db.FetchUserContentReactionParams{
 		ContentID: articleID,
@@ -1369,10 +1460,10 @@ func (server *Server) handleLikeContent(ctx echo.Context) error {
 		reactionStatus = updatedUserReaction.Reaction
 	}
 
-	// Get global settings for rendering
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	// Get the engagement policy for rendering
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error getting global settings:", err)
+		log.Println("Error getting engagement policy:", err)
 		return err
 	}
 
@@ -1408,8 +1499,10 @@ func (server *Server) handleLikeContent(ctx echo.Context) error {
 		Tags:                content.Tags,
 	}
 
+	policy.apply(convertedContent.ContentID, convertedContent.CategoryID, convertedContent.PublishedAt, &convertedContent.CommentsEnabled, &convertedContent.LikeCountEnabled, &convertedContent.DislikeCountEnabled, &convertedContent.ViewCountEnabled)
+
 	// Render the updated article stats component
-	return Render(ctx, http.StatusOK, components.ArticleStats(convertedContent, globalSettings[0], reactionStatus))
+	return Render(ctx, http.StatusOK, components.ArticleStats(convertedContent, policy.global, reactionStatus))
 }
 
 func (server *Server) handleDislikeContent(ctx echo.Context) error {
@@ -1425,6 +1518,16 @@ func (server *Server) handleDislikeContent(ctx echo.Context) error {
 		log.Println("Error getting user data in handleDislikeContent:", err)
 	}
 
+	allowed, err := server.articleEngagement(ctx.Request().Context(), articleID)
+	if err != nil {
+		log.Println("Error getting article engagement in handleDislikeContent:", err)
+		return err
+	}
+	if !allowed.Dislikes {
+		ctx.Response().Header().Set("HX-Retarget", "#user-modal")
+		return Render(ctx, http.StatusOK, components.InfoWarning("Nesviđanja su isključena za ovaj članak."))
+	}
+
 	// Check if the user already has a reaction using the efficient query
 	userReaction, err := server.store.FetchUserContentReaction(ctx.Request().Context(), db.FetchUserContentReactionParams{
 		ContentID: articleID,
@@ -1505,10 +1608,10 @@ func (server *Server) handleDislikeContent(ctx echo.Context) error {
 		reactionStatus = updatedUserReaction.Reaction
 	}
 
-	// Get global settings for rendering
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	// Get the engagement policy for rendering
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error getting global settings:", err)
+		log.Println("Error getting engagement policy:", err)
 		return err
 	}
 
@@ -1544,6 +1647,8 @@ func (server *Server) handleDislikeContent(ctx echo.Context) error {
 		Tags:                content.Tags,
 	}
 
+	policy.apply(convertedContent.ContentID, convertedContent.CategoryID, convertedContent.PublishedAt, &convertedContent.CommentsEnabled, &convertedContent.LikeCountEnabled, &convertedContent.DislikeCountEnabled, &convertedContent.ViewCountEnabled)
+
 	// Render the updated article stats component
-	return Render(ctx, http.StatusOK, components.ArticleStats(convertedContent, globalSettings[0], reactionStatus))
+	return Render(ctx, http.StatusOK, components.ArticleStats(convertedContent, policy.global, reactionStatus))
 }
@@ -33,55 +33,41 @@ func (server *Server) scheduleDailyAnalytics() {
 	c.Start()
 }
 
-func (server *Server) deactivateAds() {
-	// Create a new cron scheduler (uses the local time zone by default)
+// scheduleShadowBanExpiry publishes every hour the comments of users whose
+// shadow-ban has run out. Lifted bans publish theirs right away.
+func (server *Server) scheduleShadowBanExpiry() {
 	c := cron.New(cron.WithLocation(Loc))
 
-	// Schedule the job to run every day at midnight.
-	// "@daily" is equivalent to "0 0 0 * * *"
-	var err error
-	_, err = c.AddFunc("1 0 * * *", func() {
-		// Get the current time
-		now := time.Now().In(Loc)
-		log.Print("deactivateAds job is running..")
-
-		// Create a context with timeout for database operations
+	_, err := c.AddFunc("2 * * * *", func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		// List all active ads
-		log.Print("Listing active ads...")
-		ads, err := server.store.ListAds(ctx, 1000)
-		if err != nil {
-			log.Printf("Failed to list ads: %v\n", err)
-			return
-		}
+		server.unshadowExpiredComments(ctx)
+	})
+	if err != nil {
+		log.Fatalf("Error setting up cron job for shadow-ban expiry: %v\n", err)
+	}
+
+	c.Start()
+}
 
-		log.Print("Deactivating expired ads...")
-		for _, ad := range ads {
-			if ad.EndDate.Valid {
-				nowYear, nowMonth, nowDay := now.Date()
-				endYear, endMonth, endDay := ad.EndDate.Time.In(Loc).Date() // convert ad end date to local time
-				todayDate := time.Date(nowYear, nowMonth, nowDay, 0, 0, 0, 0, Loc)
-				adEndDate := time.Date(endYear, endMonth, endDay, 0, 0, 0, 0, Loc)
+// scheduleAdLifecycle moves ads between their flight states every hour, so
+// campaigns start, end and get archived close to when their dates say.
+func (server *Server) scheduleAdLifecycle() {
+	c := cron.New(cron.WithLocation(Loc))
 
-				// If today's date is the same as or after the ad's end date, deactivate the ad
-				if !todayDate.Before(adEndDate) {
-					_, err := server.store.DeactivateAd(ctx, ad.ID)
-					if err != nil {
-						log.Printf("Failed to deactivate ad %v: %v\n", ad.ID, err)
-					} else {
-						log.Printf("Successfully deactivated expired ad %v\n", ad.ID)
-					}
-				}
-			}
-		}
-	})
+	_, err := c.AddFunc("1 * * * *", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
 
+		server.transitionAds(ctx)
+	})
 	if err != nil {
-		log.Fatalf("Error setting up cron job for deactivating expired ads: %v\n", err)
+		log.Fatalf("Error setting up cron job for the ad lifecycle: %v\n", err)
 	}
 
-	// Start the cron scheduler in its own goroutine
+	// Catch up on whatever changed while the server was down
+	go server.transitionAds(context.Background())
+
 	c.Start()
 }
@@ -0,0 +1,335 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// The settings an engagement rule can switch, see the engagement_override
+// table
+const (
+	engagementComments = "comments"
+	engagementLikes    = "likes"
+	engagementDislikes = "dislikes"
+	engagementViews    = "views"
+	engagementAds      = "ads"
+)
+
+// commentsClosedMessage tells a reader the policy has closed an article's
+// comments, e.g. a time-boxed rule started after the page was loaded
+const commentsClosedMessage = "Komentari su zatvoreni za ovaj članak."
+
+// engagementMaxHours is how late a time-boxed rule can start, a year after
+// publishing
+const engagementMaxHours = 24 * 365
+
+// engagement is what readers can do with an article and what it shows.
+type engagement struct {
+	Comments bool
+	Likes    bool
+	Dislikes bool
+	Views    bool
+	Ads      bool
+}
+
+// engagementSubject is an article as the policy sees it, its own flags are
+// the ones the editor set on it.
+type engagementSubject struct {
+	ContentID   pgtype.UUID
+	CategoryID  pgtype.UUID
+	PublishedAt pgtype.Timestamptz
+	Comments    bool
+	Likes       bool
+	Dislikes    bool
+	Views       bool
+}
+
+// engagementPolicy holds the global settings and every category and article
+// rule, so a page's articles are resolved without more queries.
+type engagementPolicy struct {
+	global     db.GlobalSetting
+	categories map[pgtype.UUID][]db.EngagementOverride
+	contents   map[pgtype.UUID][]db.EngagementOverride
+}
+
+func (server *Server) engagementPolicy(ctx context.Context) (engagementPolicy, error) {
+	policy := engagementPolicy{
+		categories: make(map[pgtype.UUID][]db.EngagementOverride),
+		contents:   make(map[pgtype.UUID][]db.EngagementOverride),
+	}
+
+	globalSettings, err := server.store.GetGlobalSettings(ctx)
+	if err != nil {
+		return policy, err
+	}
+	if len(globalSettings) > 0 {
+		policy.global = globalSettings[0]
+	}
+
+	rules, err := server.store.ListEngagementOverrides(ctx)
+	if err != nil {
+		return policy, err
+	}
+	for _, rule := range rules {
+		if rule.CategoryID.Valid {
+			policy.categories[rule.CategoryID] = append(policy.categories[rule.CategoryID], rule)
+		} else {
+			policy.contents[rule.ContentID] = append(policy.contents[rule.ContentID], rule)
+		}
+	}
+
+	return policy, nil
+}
+
+// resolve is the one place an article's engagement is decided. A setting the
+// global settings switch off stays off. Otherwise the article's own rule wins
+// over its category's, and the category's over the article's flags. A rule
+// with after_hours only counts once the article is that old.
+func (p engagementPolicy) resolve(subject engagementSubject, now time.Time) engagement {
+	setting := func(name string, disabled bool, own bool) bool {
+		if disabled {
+			return false
+		}
+		for _, rules := range [][]db.EngagementOverride{p.contents[subject.ContentID], p.categories[subject.CategoryID]} {
+			for _, rule := range rules {
+				if rule.Setting == name && ruleInEffect(rule, subject.PublishedAt, now) {
+					return rule.Enabled
+				}
+			}
+		}
+		return own
+	}
+
+	return engagement{
+		Comments: setting(engagementComments, p.global.DisableComments, subject.Comments),
+		Likes:    setting(engagementLikes, p.global.DisableLikes, subject.Likes),
+		Dislikes: setting(engagementDislikes, p.global.DisableDislikes, subject.Dislikes),
+		Views:    setting(engagementViews, p.global.DisableViews, subject.Views),
+		Ads:      setting(engagementAds, p.global.DisableAds, true),
+	}
+}
+
+// ruleInEffect tells whether a time-boxed rule has started, unpublished
+// articles have no age.
+func ruleInEffect(rule db.EngagementOverride, publishedAt pgtype.Timestamptz, now time.Time) bool {
+	if !rule.AfterHours.Valid {
+		return true
+	}
+	if !publishedAt.Valid {
+		return false
+	}
+
+	return !now.Before(publishedAt.Time.Add(time.Duration(rule.AfterHours.Int32) * time.Hour))
+}
+
+// apply resolves a listed article and writes the result over its flags, the
+// cards then show what the policy allows.
+func (p engagementPolicy) apply(contentID, categoryID pgtype.UUID, publishedAt pgtype.Timestamptz, comments, likes, dislikes, views *bool) {
+	resolved := p.resolve(engagementSubject{
+		ContentID:   contentID,
+		CategoryID:  categoryID,
+		PublishedAt: publishedAt,
+		Comments:    *comments,
+		Likes:       *likes,
+		Dislikes:    *dislikes,
+		Views:       *views,
+	}, time.Now())
+
+	*comments = resolved.Comments
+	*likes = resolved.Likes
+	*dislikes = resolved.Dislikes
+	*views = resolved.Views
+}
+
+func (p engagementPolicy) applyContentData(content []components.ContentData) {
+	for i := range content {
+		v := &content[i]
+		p.apply(v.ContentID, v.CategoryID, v.PublishedAt, &v.CommentsEnabled, &v.LikeCountEnabled, &v.DislikeCountEnabled, &v.ViewCountEnabled)
+	}
+}
+
+// articleEngagement resolves a single article for the handlers that act on it.
+func (server *Server) articleEngagement(ctx context.Context, contentID pgtype.UUID) (engagement, error) {
+	content, err := server.store.GetContentDetails(ctx, contentID)
+	if err != nil {
+		return engagement{}, err
+	}
+
+	policy, err := server.engagementPolicy(ctx)
+	if err != nil {
+		return engagement{}, err
+	}
+
+	return policy.resolve(engagementSubject{
+		ContentID:   content.ContentID,
+		CategoryID:  content.CategoryID,
+		PublishedAt: content.PublishedAt,
+		Comments:    content.CommentsEnabled,
+		Likes:       content.LikeCountEnabled,
+		Dislikes:    content.DislikeCountEnabled,
+		Views:       content.ViewCountEnabled,
+	}, time.Now()), nil
+}
+
+// pageAdsAllowed resolves the ads of the page ads are picked for. An article
+// page follows its article, a category page its category and the other pages
+// the global settings.
+func (server *Server) pageAdsAllowed(ctx context.Context, target adTarget) (bool, error) {
+	if target.ContentID.Valid {
+		allowed, err := server.articleEngagement(ctx, target.ContentID)
+		if err == nil {
+			return allowed.Ads, nil
+		}
+		// An article deleted since the page was cached falls back to its category
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return false, err
+		}
+	}
+
+	policy, err := server.engagementPolicy(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return policy.resolve(engagementSubject{CategoryID: target.CategoryID}, time.Now()).Ads, nil
+}
+
+type EngagementRuleReq struct {
+	Setting    string `form:"setting"`
+	Enabled    bool   `form:"enabled"`
+	AfterHours int32  `form:"after_hours"`
+}
+
+// engagementRules lists the rules of a category or an article for the admin.
+func (server *Server) engagementRules(ctx echo.Context) error {
+	scope := ctx.Param("scope")
+
+	scopeID, err := utils.ParseUUID(ctx.Param("id"), "scope ID")
+	if err != nil {
+		log.Println("Invalid scope ID format in engagementRules:", err)
+		return err
+	}
+
+	return server.renderEngagementRules(ctx, scope, scopeID, "")
+}
+
+// setEngagementRule sets the rule of a category or an article for one
+// setting, replacing the one it had.
+func (server *Server) setEngagementRule(ctx echo.Context) error {
+	scope := ctx.Param("scope")
+
+	scopeID, err := utils.ParseUUID(ctx.Param("id"), "scope ID")
+	if err != nil {
+		log.Println("Invalid scope ID format in setEngagementRule:", err)
+		return err
+	}
+
+	var req EngagementRuleReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in setEngagementRule:", err)
+		return err
+	}
+
+	if !slices.Contains(components.EngagementSettingKeys(), req.Setting) {
+		return server.renderEngagementRules(ctx, scope, scopeID, "Izaberite podešavanje.")
+	}
+	if req.AfterHours < 0 || req.AfterHours > engagementMaxHours {
+		return server.renderEngagementRules(ctx, scope, scopeID, "Broj sati mora biti između 1 i 8760, ili prazan.")
+	}
+
+	afterHours := pgtype.Int4{Int32: req.AfterHours, Valid: req.AfterHours > 0}
+
+	switch scope {
+	case "category":
+		_, err = server.store.SetCategoryEngagementOverride(ctx.Request().Context(), db.SetCategoryEngagementOverrideParams{
+			CategoryID: scopeID,
+			Setting:    req.Setting,
+			Enabled:    req.Enabled,
+			AfterHours: afterHours,
+		})
+	case "content":
+		_, err = server.store.SetContentEngagementOverride(ctx.Request().Context(), db.SetContentEngagementOverrideParams{
+			ContentID:  scopeID,
+			Setting:    req.Setting,
+			Enabled:    req.Enabled,
+			AfterHours: afterHours,
+		})
+	default:
+		return echo.NewHTTPError(http.StatusNotFound, "Unknown rule scope")
+	}
+	if err != nil {
+		log.Println("Error setting engagement override in setEngagementRule:", err)
+		return err
+	}
+
+	server.purgeEngagementPages(ctx.Request().Context(), scope, scopeID)
+
+	return server.renderEngagementRules(ctx, scope, scopeID, "")
+}
+
+func (server *Server) deleteEngagementRule(ctx echo.Context) error {
+	ruleID, err := utils.ParseUUID(ctx.Param("id"), "rule ID")
+	if err != nil {
+		log.Println("Invalid rule ID format in deleteEngagementRule:", err)
+		return err
+	}
+
+	rule, err := server.store.DeleteEngagementOverride(ctx.Request().Context(), ruleID)
+	if err != nil {
+		log.Println("Error deleting engagement override in deleteEngagementRule:", err)
+		return err
+	}
+
+	scope, scopeID := "content", rule.ContentID
+	if rule.CategoryID.Valid {
+		scope, scopeID = "category", rule.CategoryID
+	}
+
+	server.purgeEngagementPages(ctx.Request().Context(), scope, scopeID)
+
+	return server.renderEngagementRules(ctx, scope, scopeID, "")
+}
+
+func (server *Server) renderEngagementRules(ctx echo.Context, scope string, scopeID pgtype.UUID, message string) error {
+	var rules []db.EngagementOverride
+	var err error
+
+	switch scope {
+	case "category":
+		rules, err = server.store.ListCategoryEngagementOverrides(ctx.Request().Context(), scopeID)
+	case "content":
+		rules, err = server.store.ListContentEngagementOverrides(ctx.Request().Context(), scopeID)
+	default:
+		return echo.NewHTTPError(http.StatusNotFound, "Unknown rule scope")
+	}
+	if err != nil {
+		log.Println("Error listing engagement overrides in renderEngagementRules:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.EngagementRules(scope, scopeID.String(), rules, message))
+}
+
+// purgeEngagementPages drops the cached pages a rule changes. A category's
+// rule reaches every article page in it, so those go with the rest of the
+// pages that follow the global settings.
+func (server *Server) purgeEngagementPages(ctx context.Context, scope string, scopeID pgtype.UUID) {
+	if scope == "category" {
+		server.purgePages(ctx, pageGlobalSettingsTag, pageCategoryTag(scopeID), pageHomeTag)
+		return
+	}
+
+	server.purgePages(ctx, server.contentPageTags(ctx, scopeID)...)
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngagementPolicyResolve(t *testing.T) {
+	contentID, categoryID := testUUID(1), testUUID(2)
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	published := pgtype.Timestamptz{Time: now.Add(-48 * time.Hour), Valid: true}
+	rule := func(enabled bool, afterHours int32) db.EngagementOverride {
+		return db.EngagementOverride{
+			Setting:    engagementComments,
+			Enabled:    enabled,
+			AfterHours: pgtype.Int4{Int32: afterHours, Valid: afterHours > 0},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		global      db.GlobalSetting
+		content     []db.EngagementOverride
+		category    []db.EngagementOverride
+		publishedAt pgtype.Timestamptz
+		own         bool
+		comments    bool
+	}{
+		{"own flag on", db.GlobalSetting{}, nil, nil, published, true, true},
+		{"own flag off", db.GlobalSetting{}, nil, nil, published, false, false},
+		{"category over own flag", db.GlobalSetting{}, nil, []db.EngagementOverride{rule(false, 0)}, published, true, false},
+		{"category opens", db.GlobalSetting{}, nil, []db.EngagementOverride{rule(true, 0)}, published, false, true},
+		{"article over category", db.GlobalSetting{}, []db.EngagementOverride{rule(true, 0)}, []db.EngagementOverride{rule(false, 0)}, published, false, true},
+		{"article closes", db.GlobalSetting{}, []db.EngagementOverride{rule(false, 0)}, []db.EngagementOverride{rule(true, 0)}, published, true, false},
+		{"global off over article", db.GlobalSetting{DisableComments: true}, []db.EngagementOverride{rule(true, 0)}, nil, published, true, false},
+		{"global off over category", db.GlobalSetting{DisableComments: true}, nil, []db.EngagementOverride{rule(true, 0)}, published, true, false},
+		{"time-boxed, started", db.GlobalSetting{}, []db.EngagementOverride{rule(false, 24)}, nil, published, true, false},
+		{"time-boxed, at the hour", db.GlobalSetting{}, []db.EngagementOverride{rule(false, 48)}, nil, published, true, false},
+		{"time-boxed, not yet", db.GlobalSetting{}, []db.EngagementOverride{rule(false, 72)}, nil, published, true, true},
+		{"time-boxed article, category meanwhile", db.GlobalSetting{}, []db.EngagementOverride{rule(true, 72)}, []db.EngagementOverride{rule(false, 0)}, published, true, false},
+		{"time-boxed, unpublished", db.GlobalSetting{}, []db.EngagementOverride{rule(false, 1)}, nil, pgtype.Timestamptz{}, true, true},
+		{"untimed, unpublished", db.GlobalSetting{}, []db.EngagementOverride{rule(false, 0)}, nil, pgtype.Timestamptz{}, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := engagementPolicy{
+				global:     tc.global,
+				categories: map[pgtype.UUID][]db.EngagementOverride{categoryID: tc.category},
+				contents:   map[pgtype.UUID][]db.EngagementOverride{contentID: tc.content},
+			}
+
+			resolved := policy.resolve(engagementSubject{
+				ContentID:   contentID,
+				CategoryID:  categoryID,
+				PublishedAt: tc.publishedAt,
+				Comments:    tc.own,
+				Likes:       true,
+			}, now)
+
+			require.Equal(t, tc.comments, resolved.Comments)
+
+			// A rule only reaches its own setting
+			require.True(t, resolved.Likes)
+			require.False(t, resolved.Dislikes)
+		})
+	}
+}
+
+func TestEngagementPolicyAds(t *testing.T) {
+	categoryID := testUUID(2)
+
+	// Pages outside any category follow the global settings alone
+	require.True(t, engagementPolicy{}.resolve(engagementSubject{}, time.Now()).Ads)
+	require.False(t, engagementPolicy{global: db.GlobalSetting{DisableAds: true}}.resolve(engagementSubject{}, time.Now()).Ads)
+
+	policy := engagementPolicy{
+		categories: map[pgtype.UUID][]db.EngagementOverride{
+			categoryID: {{Setting: engagementAds, Enabled: false}},
+		},
+	}
+	require.False(t, policy.resolve(engagementSubject{CategoryID: categoryID}, time.Now()).Ads)
+}
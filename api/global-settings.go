@@ -69,6 +69,8 @@ func (server *Server) updateGlobalSettings(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), pageGlobalSettingsTag)
+
 	return ctx.NoContent(http.StatusOK)
 }
 
@@ -79,5 +81,7 @@ func (server *Server) resetGlobalSettings(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), pageGlobalSettingsTag)
+
 	return server.adminSettings(ctx)
 }
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// imageCache is a size-bounded, least-recently-used cache of resized images on disk.
+// The index lives in memory and is rebuilt from the directory contents on startup.
+type imageCache struct {
+	dir      string
+	maxBytes int64
+
+	mu      sync.Mutex
+	size    int64
+	order   *list.List // front is the most recently used entry
+	entries map[string]*list.Element
+}
+
+type imageCacheEntry struct {
+	key  string
+	size int64
+}
+
+// newImageCache creates the cache directory if needed and indexes any files
+// left over from a previous run, oldest first.
+func newImageCache(dir string, maxBytes int64) (*imageCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("cannot create image cache dir: %w", err)
+	}
+
+	cache := &imageCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read image cache dir: %w", err)
+	}
+
+	var infos []os.FileInfo
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".webp" {
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			continue
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().Before(infos[j].ModTime())
+	})
+
+	for _, info := range infos {
+		cache.add(info.Name()[:len(info.Name())-len(".webp")], info.Size())
+	}
+	cache.evict()
+
+	return cache, nil
+}
+
+// path returns where the file for key is (or will be) stored.
+func (c *imageCache) path(key string) string {
+	return filepath.Join(c.dir, key+".webp")
+}
+
+// get reports whether key is cached and marks it as recently used.
+func (c *imageCache) get(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.order.MoveToFront(elem)
+	return true
+}
+
+// put records a file already written to path(key) and evicts old entries
+// until the cache fits within maxBytes again.
+func (c *imageCache) put(key string, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.size -= elem.Value.(*imageCacheEntry).size
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+
+	c.add(key, size)
+	c.evict()
+}
+
+// remove drops key from the index, e.g. when its file has disappeared.
+func (c *imageCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.size -= elem.Value.(*imageCacheEntry).size
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// add must be called with mu held (or before the cache is shared).
+func (c *imageCache) add(key string, size int64) {
+	elem := c.order.PushFront(&imageCacheEntry{key: key, size: size})
+	c.entries[key] = elem
+	c.size += size
+}
+
+// evict must be called with mu held (or before the cache is shared).
+func (c *imageCache) evict() {
+	for c.size > c.maxBytes && c.order.Len() > 0 {
+		elem := c.order.Back()
+		entry := elem.Value.(*imageCacheEntry)
+
+		c.order.Remove(elem)
+		delete(c.entries, entry.key)
+		c.size -= entry.size
+
+		os.Remove(c.path(entry.key))
+	}
+}
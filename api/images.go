@@ -0,0 +1,196 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/00mark0/macva-press/utils"
+	"github.com/chai2010/webp"
+	"github.com/disintegration/imaging"
+	"github.com/labstack/echo/v4"
+)
+
+const staticDir = "static"
+
+// Resized images are addressed by a signed URL that changes whenever the
+// parameters change, so browsers and proxies may keep them forever.
+const resizedImageCacheControl = "public, max-age=31536000, immutable"
+
+// newImageCacheFromEnv sets up the resized image cache from IMAGE_CACHE_DIR and
+// IMAGE_CACHE_MAX_MB, defaulting to 512MB in ./cache/img.
+func newImageCacheFromEnv() (*imageCache, error) {
+	dir := os.Getenv("IMAGE_CACHE_DIR")
+	if dir == "" {
+		dir = "cache/img"
+	}
+
+	maxMB, err := strconv.Atoi(os.Getenv("IMAGE_CACHE_MAX_MB"))
+	if err != nil || maxMB <= 0 {
+		maxMB = 512
+	}
+
+	return newImageCache(dir, int64(maxMB)*1024*1024)
+}
+
+// parseImageSize parses the "{w}x{h}" path segment. Either side may be 0 to
+// keep it proportional, but not both.
+func parseImageSize(size string) (int, int, error) {
+	wStr, hStr, ok := strings.Cut(size, "x")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid size %q", size)
+	}
+
+	width, err := strconv.Atoi(wStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid width: %w", err)
+	}
+	height, err := strconv.Atoi(hStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height: %w", err)
+	}
+
+	if width < 0 || height < 0 || (width == 0 && height == 0) ||
+		width > utils.MaxImageDimension || height > utils.MaxImageDimension {
+		return 0, 0, fmt.Errorf("size out of range: %dx%d", width, height)
+	}
+
+	return width, height, nil
+}
+
+// resizeImage serves /img/{signature}/{w}x{h}/{fit}/{path}, resizing a static
+// image on first request and answering from the disk cache afterwards.
+func (server *Server) resizeImage(ctx echo.Context) error {
+	sig := ctx.Param("sig")
+	fit := ctx.Param("fit")
+	imagePath := ctx.Param("*")
+
+	width, height, err := parseImageSize(ctx.Param("size"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid image size")
+	}
+
+	mode, focalX, focalY, ok := utils.ParseImageFit(fit)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid fit mode")
+	}
+	if mode == utils.ImageFill && (width == 0 || height == 0) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Fill requires both width and height")
+	}
+
+	if !utils.VerifyImageSignature(sig, width, height, fit, imagePath) {
+		return echo.NewHTTPError(http.StatusForbidden, "Invalid signature")
+	}
+
+	// The signature already pins the path, but never trust it to stay inside static/
+	cleanPath := strings.TrimPrefix(path.Clean("/"+imagePath), "/")
+	if cleanPath != imagePath || strings.HasPrefix(cleanPath, "..") {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid image path")
+	}
+	srcPath := filepath.Join(staticDir, filepath.FromSlash(cleanPath))
+
+	srcInfo, err := os.Stat(srcPath)
+	if err != nil || srcInfo.IsDir() {
+		return echo.NewHTTPError(http.StatusNotFound, "Image not found")
+	}
+
+	// Include the source modification time so replaced originals get a fresh variant
+	keyHash := sha256.Sum256([]byte(fmt.Sprintf("%dx%d/%s/%s/%d", width, height, fit, cleanPath, srcInfo.ModTime().UnixNano())))
+	key := hex.EncodeToString(keyHash[:16])
+	cachedPath := server.imageCache.path(key)
+
+	if !server.imageCache.get(key) {
+		size, err := server.renderResizedImage(srcPath, cachedPath, width, height, mode, focalX, focalY)
+		if err != nil {
+			log.Println("Error resizing image in resizeImage:", err)
+			return err
+		}
+		server.imageCache.put(key, size)
+	}
+
+	file, err := os.Open(cachedPath)
+	if err != nil {
+		// Evicted or removed underneath us, let the next request rebuild it
+		server.imageCache.remove(key)
+		log.Println("Error opening cached image in resizeImage:", err)
+		return err
+	}
+	defer file.Close()
+
+	res := ctx.Response()
+	res.Header().Set("Content-Type", "image/webp")
+	res.Header().Set("Cache-Control", resizedImageCacheControl)
+	res.Header().Set("ETag", `"`+key+`"`)
+
+	// ServeContent answers If-None-Match / If-Modified-Since with 304
+	http.ServeContent(res, ctx.Request(), "", srcInfo.ModTime(), file)
+	return nil
+}
+
+// renderResizedImage decodes srcPath, resizes it and writes a WebP to dstPath,
+// returning the size of the written file. Fill crops keep the focal point in frame.
+func (server *Server) renderResizedImage(srcPath, dstPath string, width, height int, mode string, focalX, focalY float32) (int64, error) {
+	// Resizing is CPU heavy, don't let a burst of cold URLs starve the server
+	select {
+	case server.resizeSemaphore <- struct{}{}:
+		defer func() { <-server.resizeSemaphore }()
+	case <-time.After(10 * time.Second):
+		return 0, echo.NewHTTPError(http.StatusServiceUnavailable, "Server is processing too many images")
+	}
+
+	img, err := imaging.Open(srcPath, imaging.AutoOrientation(true))
+	if err != nil {
+		return 0, fmt.Errorf("error decoding image: %v", err)
+	}
+
+	switch mode {
+	case utils.ImageFill:
+		img = utils.CropToFocal(img, width, height, focalX, focalY)
+	default:
+		bounds := img.Bounds()
+		// Never upscale, a blurry enlargement is worse than a smaller image
+		if width == 0 || width > bounds.Dx() {
+			width = bounds.Dx()
+		}
+		if height == 0 || height > bounds.Dy() {
+			height = bounds.Dy()
+		}
+		img = imaging.Fit(img, width, height, imaging.Lanczos)
+	}
+
+	// Write to a temp file first so concurrent readers never see a partial image
+	tmp, err := os.CreateTemp(filepath.Dir(dstPath), "resize-*.tmp")
+	if err != nil {
+		return 0, fmt.Errorf("error creating temp file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := webp.Encode(tmp, img, &webp.Options{Lossless: false, Quality: 80}); err != nil {
+		tmp.Close()
+		return 0, fmt.Errorf("error encoding to WebP: %v", err)
+	}
+
+	info, err := tmp.Stat()
+	if err != nil {
+		tmp.Close()
+		return 0, fmt.Errorf("error reading temp file: %v", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return 0, fmt.Errorf("error closing temp file: %v", err)
+	}
+
+	if err := os.Rename(tmp.Name(), dstPath); err != nil {
+		return 0, fmt.Errorf("error moving resized image into cache: %v", err)
+	}
+
+	return info.Size(), nil
+}
@@ -0,0 +1,219 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/00mark0/macva-press/db/redis"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/a-h/templ"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Comment handlers publish rendered fragments to Redis, every instance relays
+// them to the readers whose article page it streams.
+const (
+	liveChannelPrefix = "live:comments:"
+
+	// Proxies drop connections that stay silent for too long
+	liveHeartbeat = 25 * time.Second
+	// How long browsers wait before reconnecting
+	liveRetry = 5 * time.Second
+	// Open article tabs per reader
+	liveMaxPerIP = 4
+	// Events queued for a slow reader before new ones are dropped
+	liveBuffer = 16
+)
+
+// liveEvent is one update of an article's comments, Data holds htmx
+// out-of-band fragments.
+type liveEvent struct {
+	ContentID string `json:"content_id"`
+	Name      string `json:"name"`
+	Data      string `json:"data"`
+}
+
+// liveHub keeps the readers streaming each article on this instance.
+type liveHub struct {
+	maxTotal int
+
+	mu      sync.Mutex
+	readers map[string]map[chan liveEvent]struct{}
+	perIP   map[string]int
+	total   int
+}
+
+// newLiveHubFromEnv creates the hub. LIVE_COMMENTS_MAX_CONNECTIONS bounds the
+// streams one instance keeps open (default 2000).
+func newLiveHubFromEnv() *liveHub {
+	maxTotal := 2000
+	if v := os.Getenv("LIVE_COMMENTS_MAX_CONNECTIONS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			maxTotal = n
+		}
+	}
+
+	return &liveHub{
+		maxTotal: maxTotal,
+		readers:  make(map[string]map[chan liveEvent]struct{}),
+		perIP:    make(map[string]int),
+	}
+}
+
+// join adds a reader of contentID, false means the connection limits are reached.
+func (h *liveHub) join(contentID, ip string) (chan liveEvent, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.total >= h.maxTotal || h.perIP[ip] >= liveMaxPerIP {
+		return nil, false
+	}
+
+	events := make(chan liveEvent, liveBuffer)
+	if h.readers[contentID] == nil {
+		h.readers[contentID] = make(map[chan liveEvent]struct{})
+	}
+	h.readers[contentID][events] = struct{}{}
+	h.perIP[ip]++
+	h.total++
+
+	return events, true
+}
+
+func (h *liveHub) leave(contentID, ip string, events chan liveEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.readers[contentID], events)
+	if len(h.readers[contentID]) == 0 {
+		delete(h.readers, contentID)
+	}
+	h.perIP[ip]--
+	if h.perIP[ip] <= 0 {
+		delete(h.perIP, ip)
+	}
+	h.total--
+}
+
+// broadcast hands the event to the article's readers. A reader too slow to
+// keep up misses it rather than holding up the others.
+func (h *liveHub) broadcast(event liveEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for events := range h.readers[event.ContentID] {
+		select {
+		case events <- event:
+		default:
+		}
+	}
+}
+
+// relay passes the events every instance publishes to this instance's readers.
+func (h *liveHub) relay(cache *redis.CacheService) {
+	sub := cache.PSubscribe(context.Background(), liveChannelPrefix+"*")
+	defer sub.Close()
+
+	for msg := range sub.Channel() {
+		var event liveEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			log.Println("Error decoding live comment event in relay:", err)
+			continue
+		}
+		h.broadcast(event)
+	}
+}
+
+// publishCommentEvent renders component for every reader of the article, as
+// an anonymous reader sees it. Without Redis only readers of this instance
+// get the update.
+func (server *Server) publishCommentEvent(ctx context.Context, contentID pgtype.UUID, name string, component templ.Component) {
+	buf := templ.GetBuffer()
+	defer templ.ReleaseBuffer(buf)
+
+	if err := component.Render(ctx, buf); err != nil {
+		log.Println("Error rendering live comment event in publishCommentEvent:", err)
+		return
+	}
+
+	event := liveEvent{
+		ContentID: contentID.String(),
+		Name:      name,
+		Data:      buf.String(),
+	}
+
+	message, err := json.Marshal(event)
+	if err != nil {
+		log.Println("Error encoding live comment event in publishCommentEvent:", err)
+		return
+	}
+
+	err = server.cacheService.Publish(ctx, liveChannelPrefix+event.ContentID, message)
+	if err != nil {
+		log.Println("Error publishing live comment event in publishCommentEvent:", err)
+		server.liveHub.broadcast(event)
+	}
+}
+
+// liveComments streams an article's comment updates as Server-Sent Events.
+func (server *Server) liveComments(ctx echo.Context) error {
+	contentID, err := utils.ParseUUID(ctx.Param("id"), "content ID")
+	if err != nil {
+		log.Println("Invalid content ID format in liveComments:", err)
+		return err
+	}
+
+	ip := ctx.RealIP()
+	events, ok := server.liveHub.join(contentID.String(), ip)
+	if !ok {
+		return echo.NewHTTPError(http.StatusTooManyRequests, "Too many live comment connections")
+	}
+	defer server.liveHub.leave(contentID.String(), ip, events)
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	// Keeps nginx from buffering the stream
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	fmt.Fprintf(res, "retry: %d\n\n", liveRetry.Milliseconds())
+	res.Flush()
+
+	heartbeat := time.NewTicker(liveHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case event := <-events:
+			writeLiveEvent(res, event)
+		case <-heartbeat.C:
+			fmt.Fprint(res, ": heartbeat\n\n")
+		}
+		res.Flush()
+	}
+}
+
+// writeLiveEvent writes event in the text/event-stream format, one data line
+// per line of HTML.
+func writeLiveEvent(w io.Writer, event liveEvent) {
+	var b strings.Builder
+	b.WriteString("event: " + event.Name + "\n")
+	for _, line := range strings.Split(event.Data, "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+
+	io.WriteString(w, b.String())
+}
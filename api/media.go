@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"image"
-	"image/jpeg"
 	"image/png"
 	"io"
 	"log"
@@ -30,10 +29,37 @@ import (
 // ConvertToWebPWithResize converts an image file to WebP format, resizing it to fit within maxWidth and maxHeight.
 // quality is a value from 0 to 100.
 func ConvertToWebPWithResize(inputPath string, maxWidth, maxHeight int, quality float32) (string, error) {
+	return convertToWebP(inputPath, maxWidth, maxHeight, quality, nil)
+}
+
+// convertToWebP is ConvertToWebPWithResize with an optional watermark stamped
+// on after resizing, so it has the same size on every photo.
+func convertToWebP(inputPath string, maxWidth, maxHeight int, quality float32, watermark *utils.Watermark) (string, error) {
+	// Generate WebP filename (replace original extension with .webp)
+	webpPath := strings.TrimSuffix(inputPath, filepath.Ext(inputPath)) + ".webp"
+
+	if err := encodeWebP(inputPath, webpPath, maxWidth, maxHeight, quality, watermark); err != nil {
+		return inputPath, err
+	}
+
+	// Remove the original file, unless it was a WebP that has just been replaced
+	if webpPath != inputPath {
+		if err := os.Remove(inputPath); err != nil {
+			log.Printf("Warning: could not remove original file %s: %v", inputPath, err)
+		}
+	}
+
+	return webpPath, nil
+}
+
+// encodeWebP decodes inputPath, resizes it and writes it as WebP to outputPath.
+// The output is written to a temp file first so a regenerated image replaces
+// the served one in a single step.
+func encodeWebP(inputPath, outputPath string, maxWidth, maxHeight int, quality float32, watermark *utils.Watermark) error {
 	// Open the input file
 	file, err := os.Open(inputPath)
 	if err != nil {
-		return "", fmt.Errorf("error opening input file: %v", err)
+		return fmt.Errorf("error opening input file: %v", err)
 	}
 	defer file.Close()
 
@@ -42,14 +68,17 @@ func ConvertToWebPWithResize(inputPath string, maxWidth, maxHeight int, quality
 	ext := strings.ToLower(filepath.Ext(inputPath))
 	switch ext {
 	case ".jpg", ".jpeg":
-		img, err = jpeg.Decode(file)
+		// Phone cameras store rotation in EXIF instead of rotating the pixels
+		img, err = imaging.Decode(file, imaging.AutoOrientation(true))
 	case ".png":
 		img, err = png.Decode(file)
+	case ".webp":
+		img, err = webp.Decode(file)
 	default:
-		return inputPath, fmt.Errorf("unsupported image format: %s", ext)
+		return fmt.Errorf("unsupported image format: %s", ext)
 	}
 	if err != nil {
-		return "", fmt.Errorf("error decoding image: %v", err)
+		return fmt.Errorf("error decoding image: %v", err)
 	}
 
 	// Resize the image if maxWidth or maxHeight is specified (> 0)
@@ -58,27 +87,108 @@ func ConvertToWebPWithResize(inputPath string, maxWidth, maxHeight int, quality
 		img = imaging.Fit(img, maxWidth, maxHeight, imaging.Lanczos)
 	}
 
-	// Generate WebP filename (replace original extension with .webp)
-	webpPath := strings.TrimSuffix(inputPath, ext) + ".webp"
+	if watermark != nil {
+		img, err = utils.ApplyWatermark(img, *watermark)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Create WebP output file
-	output, err := os.Create(webpPath)
+	output, err := os.CreateTemp(filepath.Dir(outputPath), "webp-*.tmp")
 	if err != nil {
-		return "", fmt.Errorf("error creating WebP output file: %v", err)
+		return fmt.Errorf("error creating WebP output file: %v", err)
 	}
-	defer output.Close()
+	defer os.Remove(output.Name())
 
-	// Encode to WebP (Lossy conversion with specified quality)
+	// Encode to WebP (Lossy conversion with specified quality).
+	// Only pixel data is written, so EXIF/GPS metadata never reaches the output.
 	if err := webp.Encode(output, img, &webp.Options{Lossless: false, Quality: quality}); err != nil {
-		return "", fmt.Errorf("error encoding to WebP: %v", err)
+		output.Close()
+		return fmt.Errorf("error encoding to WebP: %v", err)
 	}
 
-	// Optionally: Remove the original file if you don't need it
-	if err := os.Remove(inputPath); err != nil {
-		log.Printf("Warning: could not remove original file %s: %v", inputPath, err)
+	if err := output.Close(); err != nil {
+		return fmt.Errorf("error closing WebP output file: %v", err)
 	}
 
-	return webpPath, nil
+	if err := os.Rename(output.Name(), outputPath); err != nil {
+		return fmt.Errorf("error moving WebP output file: %v", err)
+	}
+
+	return nil
+}
+
+// originalsDir is where untouched uploads are kept, outside of static/ so the
+// unwatermarked photos are never served.
+func originalsDir() string {
+	if dir := os.Getenv("ORIGINALS_DIR"); dir != "" {
+		return dir
+	}
+	return "originals"
+}
+
+// keepOriginal copies an upload to originalsDir before the pipeline changes it.
+func keepOriginal(filePath string) (string, error) {
+	dir := originalsDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("error creating originals directory: %v", err)
+	}
+
+	src, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("error opening upload: %v", err)
+	}
+	defer src.Close()
+
+	originalPath := filepath.Join(dir, filepath.Base(filePath))
+	dst, err := os.Create(originalPath)
+	if err != nil {
+		return "", fmt.Errorf("error creating original copy: %v", err)
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		os.Remove(originalPath)
+		return "", fmt.Errorf("error copying original: %v", err)
+	}
+
+	return originalPath, nil
+}
+
+// processUploadedImage runs an uploaded image through the media pipeline:
+// caption and credit are read from its metadata, the untouched file is kept
+// in originalsDir, then it is auto-oriented, resized, watermarked (unless
+// watermark is nil) and converted to WebP. Images that can't be converted are
+// kept as they are but with GPS and other personal metadata stripped.
+// It returns the new path, the path of the kept original, if any, and whether
+// the image went through the pipeline. Only those carry the watermark.
+func processUploadedImage(filePath string, watermark *utils.Watermark) (string, string, bool, utils.ImageMetadata) {
+	imageMeta, err := utils.ReadImageMetadata(filePath)
+	if err != nil {
+		log.Println("Error reading image metadata:", err)
+	}
+
+	originalPath, err := keepOriginal(filePath)
+	if err != nil {
+		log.Println("Error keeping original image:", err)
+	}
+
+	convertedPath, err := convertToWebP(filePath, 800, 600, 80, watermark)
+	if err != nil {
+		log.Println("Error converting image to WebP:", err)
+		// Continue with original file, but never publish its location data
+		if err := utils.StripImageMetadata(filePath); err != nil {
+			log.Println("Error stripping image metadata:", err)
+		}
+		// Nothing to regenerate from, the served file is the original
+		if originalPath != "" {
+			os.Remove(originalPath)
+		}
+		return filePath, "", false, imageMeta
+	}
+
+	return convertedPath, originalPath, true, imageMeta
 }
 
 // video
@@ -220,16 +330,20 @@ func (server *Server) addMediaToNewContent(ctx echo.Context) error {
 	}
 
 	// Process files based on media type
+	var imageMeta utils.ImageMetadata
+	var originalPath string
+	focalX, focalY := utils.DefaultFocalPoint, utils.DefaultFocalPoint
+	var imageHash pgtype.Int8
+	// Agency and partner photos are published without our logo
+	watermark := mediaType == "image" && ctx.FormValue("no_watermark") != "true"
 	if mediaType == "image" {
-		// Convert image to WebP with resize
-		convertedPath, err := ConvertToWebPWithResize(filePath, 800, 600, 80)
-		if err != nil {
-			log.Println("Error converting image to WebP:", err)
-			// Continue with original file
-		} else {
-			// Update filePath to the new WebP file
-			filePath = convertedPath
-		}
+		var converted bool
+		filePath, originalPath, converted, imageMeta = processUploadedImage(filePath, server.uploadWatermark(ctx.Request().Context(), watermark))
+		// The original is served as it is, it has no watermark now or after a rebuild
+		watermark = watermark && converted
+		// Editors can move it later, until then crop around the busiest part of the photo
+		focalX, focalY = utils.DetectFocalPoint(filePath)
+		imageHash = hashUploadedImage(filePath)
 	} else if mediaType == "video" {
 		// For videos, optimize using ffmpeg
 		log.Println("Beginning video optimization for:", filePath)
@@ -263,13 +377,19 @@ func (server *Server) addMediaToNewContent(ctx echo.Context) error {
 	arg := db.InsertMediaParams{
 		ContentID:    contentID,
 		MediaType:    mediaType,
-		MediaUrl:     "/" + filePath, // Store with leading slash for direct use in HTML
-		MediaCaption: "",             // Empty caption by default
+		MediaUrl:     "/" + filePath,    // Store with leading slash for direct use in HTML
+		MediaCaption: imageMeta.Caption, // Pre-filled from IPTC/EXIF when present
 		MediaOrder:   nextOrder,
+		MediaCredit:  imageMeta.Credit,
+		FocalX:       focalX,
+		FocalY:       focalY,
+		Phash:        imageHash,
+		OriginalPath: originalPath,
+		Watermark:    watermark,
 	}
 
 	// Use the context with timeout
-	_, err = server.store.InsertMedia(dbCtx, arg)
+	media, err := server.store.InsertMedia(dbCtx, arg)
 	if err != nil {
 		log.Println("Error inserting media record in addMediaToNewContent:", err)
 		return err
@@ -278,8 +398,10 @@ func (server *Server) addMediaToNewContent(ctx echo.Context) error {
 	// Add first media as thumbnail if this is the first one
 	if nextOrder == 1 {
 		thumbnailArg := db.AddThumbnailParams{
-			ContentID: contentID,
-			Thumbnail: pgtype.Text{String: "/" + filePath, Valid: true},
+			ContentID:       contentID,
+			Thumbnail:       pgtype.Text{String: "/" + filePath, Valid: true},
+			ThumbnailFocalX: focalX,
+			ThumbnailFocalY: focalY,
 		}
 		_, err := server.store.AddThumbnail(dbCtx, thumbnailArg)
 		if err != nil {
@@ -294,7 +416,13 @@ func (server *Server) addMediaToNewContent(ctx echo.Context) error {
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.InsertMedia(updatedMedia, contentID.String()))
+	// Warn about a photo that is already in the archive, the editor can swap it in
+	similar := server.findSimilarMedia(dbCtx, media)
+
+	return Render(ctx, http.StatusOK, templ.Join(
+		components.MediaDuplicateWarning(media, similar),
+		components.InsertMedia(updatedMedia, contentID.String()),
+	))
 }
 
 func (server *Server) addMediaToUpdateContent(ctx echo.Context) error {
@@ -385,16 +513,20 @@ func (server *Server) addMediaToUpdateContent(ctx echo.Context) error {
 	}
 
 	// Process files based on media type
+	var imageMeta utils.ImageMetadata
+	var originalPath string
+	focalX, focalY := utils.DefaultFocalPoint, utils.DefaultFocalPoint
+	var imageHash pgtype.Int8
+	// Agency and partner photos are published without our logo
+	watermark := mediaType == "image" && ctx.FormValue("no_watermark") != "true"
 	if mediaType == "image" {
-		// Convert image to WebP with resize
-		convertedPath, err := ConvertToWebPWithResize(filePath, 800, 600, 80)
-		if err != nil {
-			log.Println("Error converting image to WebP:", err)
-			// Continue with original file
-		} else {
-			// Update filePath to the new WebP file
-			filePath = convertedPath
-		}
+		var converted bool
+		filePath, originalPath, converted, imageMeta = processUploadedImage(filePath, server.uploadWatermark(ctx.Request().Context(), watermark))
+		// The original is served as it is, it has no watermark now or after a rebuild
+		watermark = watermark && converted
+		// Editors can move it later, until then crop around the busiest part of the photo
+		focalX, focalY = utils.DetectFocalPoint(filePath)
+		imageHash = hashUploadedImage(filePath)
 	} else if mediaType == "video" {
 		// For videos, optimize using ffmpeg
 		log.Println("Beginning video optimization for:", filePath)
@@ -428,9 +560,15 @@ func (server *Server) addMediaToUpdateContent(ctx echo.Context) error {
 	arg := db.InsertMediaParams{
 		ContentID:    contentID,
 		MediaType:    mediaType,
-		MediaUrl:     "/" + filePath, // Store with leading slash for direct use in HTML
-		MediaCaption: "",             // Empty caption by default
+		MediaUrl:     "/" + filePath,    // Store with leading slash for direct use in HTML
+		MediaCaption: imageMeta.Caption, // Pre-filled from IPTC/EXIF when present
 		MediaOrder:   nextOrder,
+		MediaCredit:  imageMeta.Credit,
+		FocalX:       focalX,
+		FocalY:       focalY,
+		Phash:        imageHash,
+		OriginalPath: originalPath,
+		Watermark:    watermark,
 	}
 
 	media, err := server.store.InsertMedia(dbCtx, arg)
@@ -442,8 +580,10 @@ func (server *Server) addMediaToUpdateContent(ctx echo.Context) error {
 	// Set first uploaded media as thumbnail
 	if media.MediaOrder == 1 {
 		arg := db.AddThumbnailParams{
-			ContentID: contentID,
-			Thumbnail: pgtype.Text{String: "/" + filePath, Valid: true},
+			ContentID:       contentID,
+			Thumbnail:       pgtype.Text{String: "/" + filePath, Valid: true},
+			ThumbnailFocalX: focalX,
+			ThumbnailFocalY: focalY,
 		}
 		_, err := server.store.AddThumbnail(dbCtx, arg)
 		if err != nil {
@@ -452,13 +592,22 @@ func (server *Server) addMediaToUpdateContent(ctx echo.Context) error {
 		}
 	}
 
+	// The article may already be published
+	server.purgePages(dbCtx, server.contentPageTags(dbCtx, contentID)...)
+
 	updatedMedia, err := server.store.ListMediaForContent(dbCtx, contentID)
 	if err != nil {
 		log.Println("Error listing updated media in addMediaToUpdateContent:", err)
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.InsertMediaUpdate(updatedMedia, contentID.String()))
+	// Warn about a photo that is already in the archive, the editor can swap it in
+	similar := server.findSimilarMedia(dbCtx, media)
+
+	return Render(ctx, http.StatusOK, templ.Join(
+		components.MediaDuplicateWarning(media, similar),
+		components.InsertMediaUpdate(updatedMedia, contentID.String()),
+	))
 }
 
 func (server *Server) listMediaForContent(ctx echo.Context) error {
@@ -505,20 +654,17 @@ func (server *Server) deleteMedia(ctx echo.Context) error {
 	contentID := media.ContentID
 	contentIDStr := contentID.String()
 
-	// Remove the file from filesystem
-	// The filepath is stored with leading slash, so trim it for filesystem operations
-	filePath := strings.TrimPrefix(media.MediaUrl, "/")
-	if err := os.Remove(filePath); err != nil {
-		log.Println("Error removing file from filesystem in deleteMedia:", err)
-		// Continue with DB deletion even if file removal fails
-	}
-
 	// Delete the media record from the database
 	if err := server.store.DeleteMedia(ctx.Request().Context(), mediaID); err != nil {
 		log.Println("Error deleting media record in deleteMedia:", err)
 		return err
 	}
 
+	// Remove the file from filesystem, unless a reused duplicate still points to it
+	server.removeUnusedUpload(ctx.Request().Context(), media.MediaUrl, media.OriginalPath)
+
+	server.purgePages(ctx.Request().Context(), server.contentPageTags(ctx.Request().Context(), contentID)...)
+
 	// Get updated media list for rendering
 	updatedMedia, err := server.store.ListMediaForContent(ctx.Request().Context(), contentID)
 	if err != nil {
@@ -556,3 +702,95 @@ func (server *Server) GenerateArticleMediaSliderComponent(ctx echo.Context) (tem
 
 	return components.ArticleMediaSlider(media), nil
 }
+
+type UpdateMediaCaptionReq struct {
+	MediaCaption string `form:"media_caption" validate:"max=500"`
+	MediaCredit  string `form:"media_credit" validate:"max=200"`
+}
+
+func (server *Server) updateMediaCaption(ctx echo.Context) error {
+	var req UpdateMediaCaptionReq
+
+	mediaIDStr := ctx.Param("id")
+	mediaID, err := utils.ParseUUID(mediaIDStr, "media ID")
+	if err != nil {
+		log.Println("Invalid media ID format in updateMediaCaption:", err)
+		return err
+	}
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in updateMediaCaption:", err)
+		return err
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		log.Println("Error validating request in updateMediaCaption:", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Caption or credit too long")
+	}
+
+	media, err := server.store.UpdateMediaCaption(ctx.Request().Context(), db.UpdateMediaCaptionParams{
+		MediaCaption: strings.TrimSpace(req.MediaCaption),
+		MediaCredit:  strings.TrimSpace(req.MediaCredit),
+		MediaID:      mediaID,
+	})
+	if err != nil {
+		log.Println("Error updating media caption in updateMediaCaption:", err)
+		return err
+	}
+
+	server.purgePages(ctx.Request().Context(), server.contentPageTags(ctx.Request().Context(), media.ContentID)...)
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type UpdateMediaFocalPointReq struct {
+	FocalX float32 `form:"focal_x" validate:"min=0,max=1"`
+	FocalY float32 `form:"focal_y" validate:"min=0,max=1"`
+}
+
+func (server *Server) updateMediaFocalPoint(ctx echo.Context) error {
+	var req UpdateMediaFocalPointReq
+
+	mediaIDStr := ctx.Param("id")
+	mediaID, err := utils.ParseUUID(mediaIDStr, "media ID")
+	if err != nil {
+		log.Println("Invalid media ID format in updateMediaFocalPoint:", err)
+		return err
+	}
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in updateMediaFocalPoint:", err)
+		return err
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		log.Println("Error validating request in updateMediaFocalPoint:", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Focal point must be inside the image")
+	}
+
+	media, err := server.store.UpdateMediaFocalPoint(ctx.Request().Context(), db.UpdateMediaFocalPointParams{
+		FocalX:  req.FocalX,
+		FocalY:  req.FocalY,
+		MediaID: mediaID,
+	})
+	if err != nil {
+		log.Println("Error updating media focal point in updateMediaFocalPoint:", err)
+		return err
+	}
+
+	// The thumbnail is a copy of the first image's URL, keep its crop in sync
+	err = server.store.UpdateThumbnailFocalPoint(ctx.Request().Context(), db.UpdateThumbnailFocalPointParams{
+		ThumbnailFocalX: media.FocalX,
+		ThumbnailFocalY: media.FocalY,
+		ContentID:       media.ContentID,
+		Thumbnail:       pgtype.Text{String: media.MediaUrl, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error updating thumbnail focal point in updateMediaFocalPoint:", err)
+		return err
+	}
+
+	server.purgePages(ctx.Request().Context(), server.contentPageTags(ctx.Request().Context(), media.ContentID)...)
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,350 @@
+package api
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+const uploadsURLPrefix = "/static/uploads/"
+
+// hashUploadedImage returns the perceptual hash of an uploaded image, or an
+// invalid value if it can't be decoded (the upload itself still goes through).
+func hashUploadedImage(filePath string) pgtype.Int8 {
+	hash, err := utils.ImageHash(filePath)
+	if err != nil {
+		log.Println("Error hashing image:", err)
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: hash, Valid: true}
+}
+
+// findSimilarMedia looks for already uploaded photos that look like media, one
+// entry per file. Failures only cost the editor the warning, so they are logged.
+func (server *Server) findSimilarMedia(ctx context.Context, media db.Medium) []db.ListSimilarMediaRow {
+	if !media.Phash.Valid {
+		return nil
+	}
+
+	similar, err := server.store.ListSimilarMedia(ctx, db.ListSimilarMediaParams{
+		Phash:       media.Phash.Int64,
+		MediaUrl:    media.MediaUrl,
+		MaxDistance: utils.DuplicateHashDistance,
+		MaxResults:  10,
+	})
+	if err != nil {
+		log.Println("Error listing similar media in findSimilarMedia:", err)
+		return nil
+	}
+
+	// A reused file shows up once per article using it
+	seen := make(map[string]bool)
+	var unique []db.ListSimilarMediaRow
+	for _, s := range similar {
+		if seen[s.MediaUrl] {
+			continue
+		}
+		seen[s.MediaUrl] = true
+		unique = append(unique, s)
+	}
+
+	return unique
+}
+
+// removeUnusedUpload deletes an uploaded file, and the original it was made
+// from, once no media record points to it anymore.
+func (server *Server) removeUnusedUpload(ctx context.Context, mediaURL, originalPath string) {
+	if !strings.HasPrefix(mediaURL, uploadsURLPrefix) {
+		return
+	}
+
+	count, err := server.store.CountMediaByUrl(ctx, mediaURL)
+	if err != nil {
+		log.Println("Error counting media by url in removeUnusedUpload:", err)
+		return
+	}
+	if count > 0 {
+		return
+	}
+
+	// The filepath is stored with leading slash, so trim it for filesystem operations
+	if err := os.Remove(strings.TrimPrefix(mediaURL, "/")); err != nil {
+		log.Println("Error removing file from filesystem in removeUnusedUpload:", err)
+	}
+
+	if originalPath != "" {
+		if err := os.Remove(originalPath); err != nil {
+			log.Println("Error removing original from filesystem in removeUnusedUpload:", err)
+		}
+	}
+}
+
+// hashExistingImages computes the perceptual hash for images uploaded before
+// hashing existed, so they show up in duplicate warnings and the report.
+// Images that can't be hashed are recorded, the next start skips them.
+func (server *Server) hashExistingImages() {
+	ctx := context.Background()
+
+	images, err := server.store.ListUnhashedImages(ctx)
+	if err != nil {
+		log.Println("Error listing unhashed images in hashExistingImages:", err)
+		return
+	}
+
+	hashed := 0
+	for _, image := range images {
+		phash := hashUploadedImage(strings.TrimPrefix(image.MediaUrl, "/"))
+		if !phash.Valid {
+			if err := server.store.MarkMediaHashFailed(ctx, image.MediaID); err != nil {
+				log.Println("Error marking media hash failure in hashExistingImages:", err)
+			}
+			continue
+		}
+
+		err := server.store.UpdateMediaPhash(ctx, db.UpdateMediaPhashParams{
+			Phash:   phash,
+			MediaID: image.MediaID,
+		})
+		if err != nil {
+			log.Println("Error updating media phash in hashExistingImages:", err)
+			continue
+		}
+		hashed++
+	}
+
+	if len(images) > 0 {
+		log.Printf("Hashed %d of %d existing images", hashed, len(images))
+	}
+}
+
+// reuseMedia points a freshly uploaded media item at an existing near-duplicate
+// file and removes the new upload.
+func (server *Server) reuseMedia(ctx echo.Context) error {
+	mediaID, err := utils.ParseUUID(ctx.Param("id"), "media ID")
+	if err != nil {
+		log.Println("Invalid media ID format in reuseMedia:", err)
+		return err
+	}
+
+	existingID, err := utils.ParseUUID(ctx.Param("existing_id"), "media ID")
+	if err != nil {
+		log.Println("Invalid existing media ID format in reuseMedia:", err)
+		return err
+	}
+
+	media, err := server.store.GetMediaByID(ctx.Request().Context(), mediaID)
+	if err != nil {
+		log.Println("Error getting media in reuseMedia:", err)
+		return err
+	}
+
+	existing, err := server.store.GetMediaByID(ctx.Request().Context(), existingID)
+	if err != nil {
+		log.Println("Error getting existing media in reuseMedia:", err)
+		return err
+	}
+
+	if media.MediaUrl != existing.MediaUrl {
+		if err := server.replaceMediaFile(ctx.Request().Context(), media, existing); err != nil {
+			log.Println("Error replacing media file in reuseMedia:", err)
+			return err
+		}
+	}
+
+	updatedMedia, err := server.store.ListMediaForContent(ctx.Request().Context(), media.ContentID)
+	if err != nil {
+		log.Println("Error listing updated media in reuseMedia:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.InsertMediaUpdate(updatedMedia, media.ContentID.String()))
+}
+
+// replaceMediaFile makes every media item and thumbnail using old's file use
+// keep's file instead, then deletes the old file. The pages of the articles
+// that used it are purged, they would show a broken image.
+func (server *Server) replaceMediaFile(ctx context.Context, old, keep db.Medium) error {
+	contentIDs, err := server.store.ListContentIDsByMediaUrl(ctx, old.MediaUrl)
+	if err != nil {
+		return err
+	}
+
+	err = server.store.ReplaceMediaUrl(ctx, db.ReplaceMediaUrlParams{
+		NewUrl:       keep.MediaUrl,
+		FocalX:       keep.FocalX,
+		FocalY:       keep.FocalY,
+		Phash:        keep.Phash,
+		OriginalPath: keep.OriginalPath,
+		Watermark:    keep.Watermark,
+		OldUrl:       old.MediaUrl,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = server.store.ReplaceThumbnail(ctx, db.ReplaceThumbnailParams{
+		NewThumbnail:    pgtype.Text{String: keep.MediaUrl, Valid: true},
+		ThumbnailFocalX: keep.FocalX,
+		ThumbnailFocalY: keep.FocalY,
+		OldThumbnail:    pgtype.Text{String: old.MediaUrl, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	server.removeUnusedUpload(ctx, old.MediaUrl, old.OriginalPath)
+
+	var tags []string
+	for _, contentID := range contentIDs {
+		tags = append(tags, server.contentPageTags(ctx, contentID)...)
+	}
+	server.purgePages(ctx, tags...)
+
+	return nil
+}
+
+// groupDuplicateMedia collapses media rows into files and groups files whose
+// hashes are within DuplicateHashDistance of each other, largest groups first.
+func groupDuplicateMedia(rows []db.ListHashedMediaRow) []components.DuplicateMediaGroup {
+	var files []components.DuplicateMediaFile
+	fileIndex := make(map[string]int)
+	for _, row := range rows {
+		i, ok := fileIndex[row.MediaUrl]
+		if !ok {
+			i = len(files)
+			fileIndex[row.MediaUrl] = i
+			files = append(files, components.DuplicateMediaFile{
+				MediaUrl: row.MediaUrl,
+				FocalX:   row.FocalX,
+				FocalY:   row.FocalY,
+				Phash:    row.Phash.Int64,
+			})
+		}
+		files[i].Articles = append(files[i].Articles, row.Title)
+	}
+
+	// Union-find, so A~B and B~C end up in one group even if A and C are further apart
+	parent := make([]int, len(files))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range files {
+		for j := i + 1; j < len(files); j++ {
+			if utils.HashDistance(files[i].Phash, files[j].Phash) <= utils.DuplicateHashDistance {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	groupIndex := make(map[int]int)
+	var groups []components.DuplicateMediaGroup
+	for i, file := range files {
+		root := find(i)
+		g, ok := groupIndex[root]
+		if !ok {
+			g = len(groups)
+			groupIndex[root] = g
+			groups = append(groups, components.DuplicateMediaGroup{})
+		}
+		groups[g].Files = append(groups[g].Files, file)
+	}
+
+	var duplicates []components.DuplicateMediaGroup
+	for _, group := range groups {
+		if len(group.Files) > 1 {
+			duplicates = append(duplicates, group)
+		}
+	}
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return len(duplicates[i].Files) > len(duplicates[j].Files)
+	})
+
+	return duplicates
+}
+
+func (server *Server) adminMediaDuplicates(ctx echo.Context) error {
+	rows, err := server.store.ListHashedMedia(ctx.Request().Context())
+	if err != nil {
+		log.Println("Error listing hashed media in adminMediaDuplicates:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdminMediaDuplicates(groupDuplicateMedia(rows)))
+}
+
+type MergeDuplicateMediaReq struct {
+	KeepUrl string   `form:"keep_url" validate:"required"`
+	Urls    []string `form:"urls" validate:"required,min=2"`
+}
+
+// mergeDuplicateMedia keeps one file of a duplicate group and moves every
+// article using the others over to it.
+func (server *Server) mergeDuplicateMedia(ctx echo.Context) error {
+	var req MergeDuplicateMediaReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in mergeDuplicateMedia:", err)
+		return err
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		log.Println("Error validating request in mergeDuplicateMedia:", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Nothing to merge")
+	}
+
+	// Only ever touch files that are actually in the media library
+	keep, err := server.store.GetMediaByUrl(ctx.Request().Context(), req.KeepUrl)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return echo.NewHTTPError(http.StatusBadRequest, "Unknown media file")
+		}
+		log.Println("Error getting kept media in mergeDuplicateMedia:", err)
+		return err
+	}
+
+	for _, url := range req.Urls {
+		if url == req.KeepUrl {
+			continue
+		}
+
+		old, err := server.store.GetMediaByUrl(ctx.Request().Context(), url)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return echo.NewHTTPError(http.StatusBadRequest, "Unknown media file")
+			}
+			log.Println("Error getting duplicate media in mergeDuplicateMedia:", err)
+			return err
+		}
+
+		if err := server.replaceMediaFile(ctx.Request().Context(), old, keep); err != nil {
+			log.Println("Error replacing media file in mergeDuplicateMedia:", err)
+			return err
+		}
+	}
+
+	rows, err := server.store.ListHashedMedia(ctx.Request().Context())
+	if err != nil {
+		log.Println("Error listing hashed media in mergeDuplicateMedia:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.AdminMediaDuplicates(groupDuplicateMedia(rows)))
+}
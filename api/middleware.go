@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/00mark0/macva-press/components"
 	"github.com/00mark0/macva-press/token"
+	"github.com/00mark0/macva-press/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/ulule/limiter/v3"
@@ -106,11 +108,18 @@ func (server *Server) authMiddleware(tokenMaker token.Maker) echo.MiddlewareFunc
 					return Render(ctx, http.StatusOK, components.InfoWarning("Morate biti prijavljeni da biste koristili ovu funkciju."))
 				}
 
-				if user.Banned.Bool {
-					log.Println("User is banned.")
+				sanctions, err := server.getUserSanctions(ctx.Request().Context(), user.UserID)
+				if err != nil {
+					log.Println("Error getting user sanctions in authMiddleware:", err)
+					return err
+				}
+
+				if message := sanctions.lockedMessage(user.Banned.Bool); message != "" {
+					log.Println("User is banned or suspended.")
 					ctx.Response().Header().Set("HX-Retarget", "#user-modal")
-					return Render(ctx, http.StatusOK, components.InfoWarning("Morate biti prijavljeni da biste koristili ovu funkciju."))
+					return Render(ctx, http.StatusOK, components.InfoWarning(message))
 				}
+				ctx.Set(userSanctionsKey, sanctions)
 
 				if user.IsDeleted.Bool {
 					log.Println("User is deleted.")
@@ -190,11 +199,18 @@ func (server *Server) authMiddleware(tokenMaker token.Maker) echo.MiddlewareFunc
 					return Render(ctx, http.StatusOK, components.InfoWarning("Morate biti prijavljeni da biste koristili ovu funkciju."))
 				}
 
-				if user.Banned.Bool {
-					log.Println("User is banned.")
+				sanctions, err := server.getUserSanctions(ctx.Request().Context(), user.UserID)
+				if err != nil {
+					log.Println("Error getting user sanctions in authMiddleware:", err)
+					return err
+				}
+
+				if message := sanctions.lockedMessage(user.Banned.Bool); message != "" {
+					log.Println("User is banned or suspended.")
 					ctx.Response().Header().Set("HX-Retarget", "#user-modal")
-					return Render(ctx, http.StatusOK, components.InfoWarning("Morate biti prijavljeni da biste koristili ovu funkciju."))
+					return Render(ctx, http.StatusOK, components.InfoWarning(message))
 				}
+				ctx.Set(userSanctionsKey, sanctions)
 
 				if user.IsDeleted.Bool {
 					log.Println("User is deleted.")
@@ -211,21 +227,42 @@ func (server *Server) authMiddleware(tokenMaker token.Maker) echo.MiddlewareFunc
 	}
 }
 
+// currentUserID returns the user signed in for the request, set by the
+// middlewares, or an invalid UUID without one.
+func currentUserID(ctx echo.Context) pgtype.UUID {
+	payload, ok := ctx.Get(authorizationPayloadKey).(*token.Payload)
+	if !ok {
+		return pgtype.UUID{}
+	}
+
+	userID, err := utils.ParseUUID(payload.UserID, "user_id")
+	if err != nil {
+		return pgtype.UUID{}
+	}
+
+	return userID
+}
+
 func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFunc {
+	return server.roleMiddleware(tokenMaker, "admin")
+}
+
+// roleMiddleware lets through signed in users with one of roles.
+func (server *Server) roleMiddleware(tokenMaker token.Maker, roles ...string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
 			cookie, err := ctx.Cookie("access_token")
 			if err != nil {
 				refreshCookie, err := ctx.Cookie("refresh_token")
 				if err != nil {
-					log.Println("Error getting refresh cookie in adminMiddleware:", err)
+					log.Println("Error getting refresh cookie in roleMiddleware:", err)
 					return ctx.NoContent(http.StatusNoContent)
 				}
 				refreshToken := refreshCookie.Value
 
 				refreshPayload, err := tokenMaker.VerifyToken(refreshToken)
 				if err != nil {
-					log.Println("Error verifying refresh token in adminMiddleware:", err)
+					log.Println("Error verifying refresh token in roleMiddleware:", err)
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
@@ -233,7 +270,7 @@ func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFun
 
 				parsedUserID, err := uuid.Parse(userIDStr)
 				if err != nil {
-					log.Println("Invalid content ID format in adminMiddleware:", err)
+					log.Println("Invalid user ID format in roleMiddleware:", err)
 					return err
 				}
 
@@ -249,8 +286,14 @@ func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFun
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
-				if user.Banned.Bool {
-					log.Println("User is banned.")
+				sanctions, err := server.getUserSanctions(ctx.Request().Context(), user.UserID)
+				if err != nil {
+					log.Println("Error getting user sanctions in roleMiddleware:", err)
+					return err
+				}
+
+				if sanctions.lockedMessage(user.Banned.Bool) != "" {
+					log.Println("User is banned or suspended.")
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
@@ -259,15 +302,15 @@ func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFun
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
-				if user.Role != "admin" {
-					log.Println("User is not admin.")
+				if !slices.Contains(roles, user.Role) {
+					log.Println("User does not have the role.")
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
 				accessTokenDurationStr := os.Getenv("ACCESS_TOKEN_DURATION")
 				accessTokenDuration, err := time.ParseDuration(accessTokenDurationStr)
 				if err != nil {
-					log.Println("Error parsing access token duration in adminMiddleware:", err)
+					log.Println("Error parsing access token duration in roleMiddleware:", err)
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
@@ -283,7 +326,7 @@ func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFun
 					accessTokenDuration,
 				)
 				if err != nil {
-					log.Println("Error creating access token in adminMiddleware:", err)
+					log.Println("Error creating access token in roleMiddleware:", err)
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
@@ -302,7 +345,7 @@ func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFun
 
 				payload, err := tokenMaker.VerifyToken(accessToken)
 				if err != nil {
-					log.Println("Error verifying access token in adminMiddleware:", err)
+					log.Println("Error verifying access token in roleMiddleware:", err)
 					// Invalid token; redirect to login page
 					return ctx.NoContent(http.StatusNoContent)
 				}
@@ -311,7 +354,7 @@ func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFun
 
 				parsedUserID, err := uuid.Parse(userIDStr)
 				if err != nil {
-					log.Println("Invalid content ID format in adminMiddleware:", err)
+					log.Println("Invalid user ID format in roleMiddleware:", err)
 					return err
 				}
 
@@ -327,8 +370,14 @@ func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFun
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
-				if user.Banned.Bool {
-					log.Println("User is banned.")
+				sanctions, err := server.getUserSanctions(ctx.Request().Context(), user.UserID)
+				if err != nil {
+					log.Println("Error getting user sanctions in roleMiddleware:", err)
+					return err
+				}
+
+				if sanctions.lockedMessage(user.Banned.Bool) != "" {
+					log.Println("User is banned or suspended.")
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
@@ -337,8 +386,8 @@ func (server *Server) adminMiddleware(tokenMaker token.Maker) echo.MiddlewareFun
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
-				if user.Role != "admin" {
-					log.Println("User is not admin.")
+				if !slices.Contains(roles, user.Role) {
+					log.Println("User does not have the role.")
 					return ctx.NoContent(http.StatusNoContent)
 				}
 
@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// notificationsPageSize is how many notifications /obavestenja lists
+const notificationsPageSize = 50
+
+// Notification kinds
+const (
+	notificationReply   = "reply"
+	notificationMention = "mention"
+)
+
+// notifyCommentRecipients notifies the author of the comment that was
+// answered, if any, and everyone the comment mentions. The author of the
+// comment itself is never notified, and a reader who is both answered and
+// mentioned only gets the reply notification.
+func (server *Server) notifyCommentRecipients(ctx context.Context, comment db.Comment, author db.GetUserByIDRow, replyToID pgtype.UUID) {
+	type recipient struct {
+		userID pgtype.UUID
+		email  string
+		kind   string
+	}
+	var recipients []recipient
+	seen := map[pgtype.UUID]bool{author.UserID: true}
+
+	if replyToID.Valid {
+		replyTo, err := server.store.GetCommentByID(ctx, replyToID)
+		if err != nil {
+			log.Println("Error getting replied comment in notifyCommentRecipients:", err)
+		} else if !seen[replyTo.UserID] {
+			replyToUser, err := server.store.GetUserByID(ctx, replyTo.UserID)
+			if err != nil {
+				log.Println("Error getting replied user in notifyCommentRecipients:", err)
+			} else {
+				seen[replyTo.UserID] = true
+				recipients = append(recipients, recipient{replyTo.UserID, replyToUser.Email, notificationReply})
+			}
+		}
+	}
+
+	if usernames := utils.ParseMentions(comment.CommentText); len(usernames) > 0 {
+		mentioned, err := server.store.GetUsersByUsernames(ctx, usernames)
+		if err != nil {
+			log.Println("Error getting mentioned users in notifyCommentRecipients:", err)
+		}
+		for _, user := range mentioned {
+			if seen[user.UserID] {
+				continue
+			}
+			seen[user.UserID] = true
+			recipients = append(recipients, recipient{user.UserID, user.Email, notificationMention})
+		}
+	}
+
+	var contentTitle string
+	for _, r := range recipients {
+		created, err := server.store.CreateNotification(ctx, db.CreateNotificationParams{
+			UserID:    r.userID,
+			ActorID:   author.UserID,
+			CommentID: comment.CommentID,
+			Kind:      r.kind,
+		})
+		if err != nil {
+			log.Println("Error creating notification in notifyCommentRecipients:", err)
+			continue
+		}
+		if created == 0 {
+			continue
+		}
+
+		settings, err := server.store.GetNotificationSettings(ctx, r.userID)
+		if err != nil {
+			log.Println("Error getting notification settings in notifyCommentRecipients:", err)
+			continue
+		}
+		if (r.kind == notificationReply && !settings.EmailReplies) || (r.kind == notificationMention && !settings.EmailMentions) {
+			continue
+		}
+
+		if contentTitle == "" {
+			content, err := server.store.GetContentDetails(ctx, comment.ContentID)
+			if err != nil {
+				log.Println("Error getting content details in notifyCommentRecipients:", err)
+				return
+			}
+			contentTitle = content.Title
+		}
+
+		commentLink := fmt.Sprintf("%s/komentar/%s", BaseUrl, comment.CommentID.String())
+		go func(email, kind string) {
+			err := utils.SendCommentNotificationEmail(email, author.Username, contentTitle, kind == notificationMention, commentLink)
+			if err != nil {
+				log.Println("Error sending notification email in notifyCommentRecipients:", err)
+			}
+		}(r.email, r.kind)
+	}
+}
+
+// unreadNotifications renders the unread count badge of the header.
+func (server *Server) unreadNotifications(ctx echo.Context) error {
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in unreadNotifications:", err)
+		return err
+	}
+
+	count, err := server.store.CountUnreadNotifications(ctx.Request().Context(), userData.UserID)
+	if err != nil {
+		log.Println("Error counting unread notifications in unreadNotifications:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.NotificationBadge(int(count)))
+}
+
+func (server *Server) notificationsPage(ctx echo.Context) error {
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in notificationsPage:", err)
+		return err
+	}
+
+	notifications, err := server.store.ListNotifications(ctx.Request().Context(), db.ListNotificationsParams{
+		UserID: userData.UserID,
+		Limit:  notificationsPageSize,
+	})
+	if err != nil {
+		log.Println("Error listing notifications in notificationsPage:", err)
+		return err
+	}
+
+	meta := components.Meta{
+		Title:       "Mačva Press | Обавештења",
+		Description: "Обавештења",
+		Canonical:   BaseUrl + "/obavestenja",
+		OpenGraph: components.OpenGraphMeta{
+			Title:       "Mačva Press | Обавештења",
+			Description: "Обавештења",
+			URL:         BaseUrl + "/obavestenja",
+			Type:        "website",
+			Image:       BaseUrl + "/static/assets/macva-1-300x71.png",
+		},
+		Twitter: components.TwitterCardMeta{
+			Card:        "summary_large_image",
+			Title:       "Mačva Press | Обавештења",
+			Description: "Обавештења",
+			Image:       BaseUrl + "/static/assets/macva-1-300x71.png",
+			Creator:     "@MacvaNews",
+		},
+	}
+
+	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
+	if err != nil {
+		log.Println("Error listing categories in notificationsPage:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.NotificationsPage(userData, meta, categories, notifications))
+}
+
+// openNotification marks a notification read and sends the reader to the comment.
+func (server *Server) openNotification(ctx echo.Context) error {
+	notificationID, err := utils.ParseUUID(ctx.Param("id"), "notification ID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Notification not found")
+	}
+
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in openNotification:", err)
+		return err
+	}
+
+	var commentID pgtype.UUID
+	if utils.SiteModeFromContext(ctx.Request().Context()).Mode != utils.SiteModeNormal {
+		// Marking it read is a write, it stays unread until the site is back to normal
+		commentID, err = server.store.GetNotificationComment(ctx.Request().Context(), db.GetNotificationCommentParams{
+			NotificationID: notificationID,
+			UserID:         userData.UserID,
+		})
+	} else {
+		commentID, err = server.store.MarkNotificationRead(ctx.Request().Context(), db.MarkNotificationReadParams{
+			NotificationID: notificationID,
+			UserID:         userData.UserID,
+		})
+	}
+	if err != nil {
+		log.Println("Error marking notification read in openNotification:", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Notification not found")
+	}
+
+	return ctx.Redirect(http.StatusFound, "/komentar/"+commentID.String())
+}
+
+func (server *Server) markAllNotificationsRead(ctx echo.Context) error {
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in markAllNotificationsRead:", err)
+		return err
+	}
+
+	err = server.store.MarkAllNotificationsRead(ctx.Request().Context(), userData.UserID)
+	if err != nil {
+		log.Println("Error marking notifications read in markAllNotificationsRead:", err)
+		return err
+	}
+
+	notifications, err := server.store.ListNotifications(ctx.Request().Context(), db.ListNotificationsParams{
+		UserID: userData.UserID,
+		Limit:  notificationsPageSize,
+	})
+	if err != nil {
+		log.Println("Error listing notifications in markAllNotificationsRead:", err)
+		return err
+	}
+
+	ctx.Response().Header().Set("HX-Trigger", "notificationsRead")
+
+	return Render(ctx, http.StatusOK, components.NotificationList(notifications))
+}
+
+type NotificationSettingsReq struct {
+	EmailReplies  bool `form:"email_replies"`
+	EmailMentions bool `form:"email_mentions"`
+}
+
+func (server *Server) updateNotificationSettings(ctx echo.Context) error {
+	var req NotificationSettingsReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in updateNotificationSettings:", err)
+		return err
+	}
+
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in updateNotificationSettings:", err)
+		return err
+	}
+
+	err = server.store.UpsertNotificationSettings(ctx.Request().Context(), db.UpsertNotificationSettingsParams{
+		UserID:        userData.UserID,
+		EmailReplies:  req.EmailReplies,
+		EmailMentions: req.EmailMentions,
+	})
+	if err != nil {
+		log.Println("Error updating notification settings in updateNotificationSettings:", err)
+		return Render(ctx, http.StatusOK, components.UpdateError("Грешка при чувању подешавања обавештења."))
+	}
+
+	return Render(ctx, http.StatusOK, components.UpdateSuccess("Подешавања обавештења су сачувана."))
+}
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/00mark0/macva-press/db/redis"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Cached pages are served as they are for pageCacheFresh, then served stale
+// for up to pageCacheStale more while one request renders them again.
+const (
+	pageCacheFresh = time.Minute
+	pageCacheStale = 10 * time.Minute
+)
+
+// Page cache tags, every page is purged when the category menu changes. Ads
+// are loaded separately, see components.AdSlot.
+const (
+	pageHomeTag           = "page:home"
+	pageCategoriesTag     = "page:categories"
+	pageGlobalSettingsTag = "page:global_settings"
+)
+
+func pageContentTag(contentID pgtype.UUID) string {
+	return redis.GenerateKey("page:content", contentID)
+}
+
+func pageCategoryTag(categoryID pgtype.UUID) string {
+	return redis.GenerateKey("page:category", categoryID)
+}
+
+func pageTagTag(tagID pgtype.UUID) string {
+	return redis.GenerateKey("page:tag", tagID)
+}
+
+// Echo context keys the page handlers use to describe what they rendered
+const (
+	pageCacheTagsKey = "page_cache_tags"
+	pageCacheViewKey = "page_cache_view"
+)
+
+type pageCacheEntry struct {
+	Body     []byte
+	StoredAt time.Time
+	// Article whose view is counted each time the page is served from cache
+	ViewContentID string
+}
+
+// pageRevalidationKey marks the internal request that re-renders a stale page
+type pageRevalidationKey struct{}
+
+// addPageTags records what the page being rendered shows, so changing it purges the page.
+func addPageTags(ctx echo.Context, tags ...string) {
+	existing, _ := ctx.Get(pageCacheTagsKey).([]string)
+	ctx.Set(pageCacheTagsKey, append(existing, tags...))
+}
+
+// setPageView makes cache hits of the page count as views of the article.
+func setPageView(ctx echo.Context, contentID pgtype.UUID) {
+	ctx.Set(pageCacheViewKey, contentID.String())
+}
+
+// isPageRevalidation reports whether the page is rendered in the background for
+// the cache rather than for a reader, so it mustn't count as a view.
+func isPageRevalidation(ctx echo.Context) bool {
+	return ctx.Request().Context().Value(pageRevalidationKey{}) != nil
+}
+
+// isAnonymousRequest reports whether the reader is logged out. Logged out
+// readers all get the same HTML, the anon_token cookie only counts views.
+func isAnonymousRequest(req *http.Request) bool {
+	for _, name := range []string{"access_token", "refresh_token"} {
+		if _, err := req.Cookie(name); err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// pageCacheParams are the query parameters that leave a page as it is, the
+// trackers shared links carry. They are left out of the cache key.
+var pageCacheParams = map[string]bool{
+	"utm_source":   true,
+	"utm_medium":   true,
+	"utm_campaign": true,
+	"utm_term":     true,
+	"utm_content":  true,
+	"fbclid":       true,
+	"gclid":        true,
+}
+
+// pageCacheKey keys a page by its path. Pages with any other query parameter,
+// e.g. a comment permalink, aren't cached, or anyone could fill Redis with
+// made-up URLs.
+func pageCacheKey(req *http.Request) (string, bool) {
+	for name := range req.URL.Query() {
+		if !pageCacheParams[name] {
+			return "", false
+		}
+	}
+
+	return redis.GenerateKey("page", req.Header.Get("HX-Request") == "true", req.URL.Path), true
+}
+
+// pageCacheWriter copies everything the handler writes so it can be cached
+type pageCacheWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *pageCacheWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *pageCacheWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// discardResponseWriter is the response of a background revalidation, only
+// the copy kept by pageCacheWriter matters
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header         { return w.header }
+func (w *discardResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardResponseWriter) WriteHeader(int)             {}
+
+// pageCacheMiddleware serves logged out readers rendered pages from Redis.
+// Logged in readers and everything but GET go straight to the handler.
+func (server *Server) pageCacheMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		if req.Method != http.MethodGet || !isAnonymousRequest(req) {
+			return next(ctx)
+		}
+
+		key, cacheable := pageCacheKey(req)
+		if !cacheable {
+			return next(ctx)
+		}
+
+		if !isPageRevalidation(ctx) {
+			var entry pageCacheEntry
+			cacheHit, err := server.cacheService.Get(req.Context(), key, &entry)
+			if err != nil {
+				log.Printf("Error fetching page from cache: %v", err)
+			}
+			if cacheHit {
+				status := "HIT"
+				if time.Since(entry.StoredAt) > pageCacheFresh {
+					status = "STALE"
+					server.revalidatePage(req, key)
+				}
+
+				if entry.ViewContentID != "" {
+					server.handleViews(ctx, entry.ViewContentID, "")
+				}
+
+				ctx.Response().Header().Set("X-Page-Cache", status)
+				return ctx.HTMLBlob(http.StatusOK, entry.Body)
+			}
+		}
+
+		res := ctx.Response()
+		writer := &pageCacheWriter{ResponseWriter: res.Writer}
+		res.Writer = writer
+		err := next(ctx)
+		res.Writer = writer.ResponseWriter
+		if err != nil || res.Status != http.StatusOK {
+			return err
+		}
+
+		entry := pageCacheEntry{
+			Body:     writer.body.Bytes(),
+			StoredAt: time.Now(),
+		}
+		entry.ViewContentID, _ = ctx.Get(pageCacheViewKey).(string)
+
+		pageTags, _ := ctx.Get(pageCacheTagsKey).([]string)
+		tags := append([]string{pageCategoriesTag}, pageTags...)
+
+		err = server.cacheService.SetWithTags(req.Context(), key, entry, pageCacheFresh+pageCacheStale, tags...)
+		if err != nil {
+			log.Printf("Error caching page: %v", err)
+		}
+
+		return nil
+	}
+}
+
+// revalidatePage renders a stale page again in the background. Only one
+// request per page does it, the others keep getting the stale copy meanwhile.
+func (server *Server) revalidatePage(req *http.Request, key string) {
+	lockKey := key + ":revalidating"
+	locked, err := server.cacheService.TryLock(req.Context(), lockKey, 30*time.Second)
+	if err != nil || !locked {
+		return
+	}
+
+	revalidation, err := http.NewRequestWithContext(
+		context.WithValue(context.Background(), pageRevalidationKey{}, true),
+		http.MethodGet, req.URL.RequestURI(), nil,
+	)
+	if err != nil {
+		log.Println("Error creating request in revalidatePage:", err)
+		return
+	}
+	revalidation.Host = req.Host
+	if hx := req.Header.Get("HX-Request"); hx != "" {
+		revalidation.Header.Set("HX-Request", hx)
+	}
+
+	go func() {
+		defer server.cacheService.Delete(context.Background(), lockKey)
+		server.router.ServeHTTP(&discardResponseWriter{header: http.Header{}}, revalidation)
+	}()
+}
+
+// purgePages drops every cached page carrying one of the tags.
+func (server *Server) purgePages(ctx context.Context, tags ...string) {
+	if err := server.cacheService.InvalidateTags(ctx, tags...); err != nil {
+		log.Printf("Failed to purge cached pages: %v", err)
+	}
+}
+
+// contentPageTags returns the tags of the pages an article shows up on: its
+// own page, the home page, its category page and the pages of its tags.
+// Look them up before a change that moves or removes the article.
+func (server *Server) contentPageTags(ctx context.Context, contentID pgtype.UUID) []string {
+	tags := []string{pageContentTag(contentID), pageHomeTag}
+
+	content, err := server.store.GetContentDetails(ctx, contentID)
+	if err != nil {
+		log.Println("Error getting content details in contentPageTags:", err)
+	} else {
+		tags = append(tags, pageCategoryTag(content.CategoryID))
+	}
+
+	contentTags, err := server.store.GetTagsByContent(ctx, contentID)
+	if err != nil {
+		log.Println("Error getting tags by content in contentPageTags:", err)
+	}
+	for _, tag := range contentTags {
+		tags = append(tags, pageTagTag(tag.TagID))
+	}
+
+	return tags
+}
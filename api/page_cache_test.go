@@ -0,0 +1,43 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageCacheKey(t *testing.T) {
+	testCases := []struct {
+		name      string
+		url       string
+		sameAs    string
+		cacheable bool
+	}{
+		{"plain path", "/kategorije/vesti", "/kategorije/vesti", true},
+		{"tracking parameters", "/kategorije/vesti?utm_source=facebook&fbclid=abc", "/kategorije/vesti", true},
+		{"made-up parameter", "/kategorije/vesti?x=123", "", false},
+		{"comment permalink", "/2025/03/clanak?komentar=0b1c", "", false},
+		{"tracking and made-up parameter", "/?utm_source=x&x=1", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, cacheable := pageCacheKey(httptest.NewRequest("GET", tc.url, nil))
+			require.Equal(t, tc.cacheable, cacheable)
+			if !tc.cacheable {
+				return
+			}
+
+			expected, _ := pageCacheKey(httptest.NewRequest("GET", tc.sameAs, nil))
+			require.Equal(t, expected, key)
+		})
+	}
+
+	// htmx swaps get their own fragment
+	req := httptest.NewRequest("GET", "/", nil)
+	full, _ := pageCacheKey(req)
+	req.Header.Set("HX-Request", "true")
+	fragment, _ := pageCacheKey(req)
+	require.NotEqual(t, full, fragment)
+}
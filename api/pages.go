@@ -57,12 +57,7 @@ func (server *Server) homePage(ctx echo.Context) error {
 			Image:       BaseUrl + "/static/assets/macva-1-300x71.png", // Prepare a Twitter card image
 			Creator:     "@MacvaNews",                                  // Optional: your Twitter handle
 		},
-	}
-
-	activeAds, err := server.store.ListActiveAds(ctx.Request().Context(), 11)
-	if err != nil {
-		log.Println("Error listing active ads in homePage:", err)
-		return err
+		Ads: components.AdContext{PageType: "home"},
 	}
 
 	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
@@ -77,8 +72,10 @@ func (server *Server) homePage(ctx echo.Context) error {
 		return err
 	}
 
+	addPageTags(ctx, pageHomeTag)
+
 	// Render the Index template with the pre-rendered slider
-	return Render(ctx, http.StatusOK, components.Index(userData, meta, activeAds, categories, prerenderedSlider))
+	return Render(ctx, http.StatusOK, components.Index(userData, meta, categories, prerenderedSlider))
 }
 
 // full page to be served
@@ -158,6 +155,22 @@ func (server *Server) updateCategoryForm(ctx echo.Context) error {
 	return Render(ctx, http.StatusOK, components.UpdateCategoryForm(category, updateCategoryErr))
 }
 
+func (server *Server) categoryRulesModal(ctx echo.Context) error {
+	categoryID, err := utils.ParseUUID(ctx.Param("id"), "category ID")
+	if err != nil {
+		log.Println("Invalid category ID format in categoryRulesModal:", err)
+		return err
+	}
+
+	category, err := server.store.GetCategoryByID(ctx.Request().Context(), categoryID)
+	if err != nil {
+		log.Println("Error getting category in categoryRulesModal:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.CategoryRulesModal(category))
+}
+
 type ListPublishedLimitReq struct {
 	Limit int32 `query:"limit"`
 }
@@ -201,6 +214,7 @@ func (server *Server) adminArts(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -244,6 +258,7 @@ func (server *Server) publishedContentList(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -286,6 +301,7 @@ func (server *Server) draftContentList(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -328,6 +344,7 @@ func (server *Server) deletedContentList(ctx echo.Context) error {
 			UpdatedAt:           v.UpdatedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			PublishedAt:         v.PublishedAt.Time.In(Loc).Format("02-01-06 15:04"),
 			IsDeleted:           v.IsDeleted.Bool,
+			IsSponsored:         v.IsSponsored,
 			Username:            v.Username,
 			CategoryName:        v.CategoryName,
 		})
@@ -561,8 +578,30 @@ func (server *Server) scheduledAdsList(ctx echo.Context) error {
 	return Render(ctx, http.StatusOK, components.ScheduledAdsSort(int(nextLimit), scheduledAds, url))
 }
 
+func (server *Server) archivedAdsList(ctx echo.Context) error {
+	var req ListAdsReq
+
+	nextLimit := req.Limit + 20
+
+	archivedAds, err := server.store.ListArchivedAds(ctx.Request().Context(), nextLimit)
+	if err != nil {
+		log.Println("Error listing archived ads in archivedAdsList:", err)
+		return err
+	}
+
+	url := "/api/admin/ads/archived?limit="
+
+	return Render(ctx, http.StatusOK, components.ArchivedAdsSort(int(nextLimit), archivedAds, url))
+}
+
 func (server *Server) createAdModal(ctx echo.Context) error {
-	return Render(ctx, http.StatusOK, components.CreateAdModal(""))
+	data, err := server.adFormData(ctx)
+	if err != nil {
+		log.Println("Error getting ad form data in createAdModal:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.CreateAdModal("", data))
 }
 
 func (server *Server) updateAdModal(ctx echo.Context) error {
@@ -580,7 +619,19 @@ func (server *Server) updateAdModal(ctx echo.Context) error {
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.UpdateAdModal("", ad))
+	targeting, err := server.adTargeting(ctx, ad.ID)
+	if err != nil {
+		log.Println("Error getting ad targeting in updateAdModal:", err)
+		return err
+	}
+
+	data, err := server.adFormData(ctx)
+	if err != nil {
+		log.Println("Error getting ad form data in updateAdModal:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.UpdateAdModal("", ad, targeting, data))
 }
 
 func (server *Server) loginPage(ctx echo.Context) error {
@@ -654,7 +705,7 @@ func (server *Server) updateArticlePage(ctx echo.Context) error {
 		SameSite: http.SameSiteStrictMode,
 	})
 
-	return Render(ctx, http.StatusOK, components.UpdateArticle(content, categories, media, tags, contentTags))
+	return Render(ctx, http.StatusOK, components.UpdateArticle(content, categories, media, tags, contentTags, sponsorReportURL(content.ContentID.String())))
 }
 
 func (server *Server) adminSettings(ctx echo.Context) error {
@@ -688,6 +739,19 @@ func (server *Server) adminSettings(ctx echo.Context) error {
 		DisableDislikes: globalSettings[0].DisableDislikes,
 		DisableViews:    globalSettings[0].DisableViews,
 		DisableAds:      globalSettings[0].DisableAds,
+
+		WatermarkEnabled:  globalSettings[0].WatermarkEnabled,
+		WatermarkLogo:     globalSettings[0].WatermarkLogo,
+		WatermarkPosition: globalSettings[0].WatermarkPosition,
+		WatermarkOpacity:  globalSettings[0].WatermarkOpacity,
+		WatermarkScale:    globalSettings[0].WatermarkScale,
+		WatermarkLogos:    utils.WatermarkLogos(),
+		CommentMaxDepth:   globalSettings[0].CommentMaxDepth,
+		CommentMaxLinks:   globalSettings[0].CommentMaxLinks,
+		CommentBlocklist:  globalSettings[0].CommentBlocklist,
+		CommentEditWindow: globalSettings[0].CommentEditWindow,
+		SiteMode:          globalSettings[0].SiteMode,
+		SiteModeMessage:   globalSettings[0].SiteModeMessage,
 	}
 
 	// Render the AdminSettings component with the props
@@ -825,25 +889,23 @@ func (server *Server) searchResultsPage(ctx echo.Context) error {
 		},
 	}
 
-	activeAds, err := server.store.ListActiveAds(ctx.Request().Context(), 11)
-	if err != nil {
-		log.Println("Error listing active ads in homePage:", err)
-		return err
-	}
-
 	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
 	if err != nil {
 		log.Println("Error listing categories in homePage:", err)
 		return err
 	}
 
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error getting global settings in homePage:", err)
+		log.Println("Error getting engagement policy in searchResultsPage:", err)
 		return err
 	}
+	for i := range searchResults {
+		v := &searchResults[i]
+		policy.apply(v.ContentID, v.CategoryID, v.PublishedAt, &v.CommentsEnabled, &v.LikeCountEnabled, &v.DislikeCountEnabled, &v.ViewCountEnabled)
+	}
 
-	return Render(ctx, http.StatusOK, components.SearchPage(userData, meta, activeAds, categories, searchResults, searchResultsCount, searchTerm, int(nextLimit), globalSettings[0]))
+	return Render(ctx, http.StatusOK, components.SearchPage(userData, meta, categories, searchResults, searchResultsCount, searchTerm, int(nextLimit), policy.global))
 }
 
 func (server *Server) categoriesPage(ctx echo.Context) error {
@@ -879,12 +941,7 @@ func (server *Server) categoriesPage(ctx echo.Context) error {
 			Image:       BaseUrl + "/static/assets/macva-1-300x71.png", // Koristi istu sliku
 			Creator:     "@MacvaNews",                                  // Opcionalno: vaš Twitter nalog
 		},
-	}
-
-	activeAds, err := server.store.ListActiveAds(ctx.Request().Context(), 11)
-	if err != nil {
-		log.Println("Error listing active ads in homePage:", err)
-		return err
+		Ads: components.AdContext{PageType: "category"},
 	}
 
 	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
@@ -899,7 +956,9 @@ func (server *Server) categoriesPage(ctx echo.Context) error {
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.CategoriesPage(userData, meta, activeAds, categories, category, recentCatComponent))
+	addPageTags(ctx, pageCategoryTag(category.CategoryID))
+
+	return Render(ctx, http.StatusOK, components.CategoriesPage(userData, meta, categories, category, recentCatComponent))
 }
 
 func (server *Server) tagPage(ctx echo.Context) error {
@@ -935,12 +994,7 @@ func (server *Server) tagPage(ctx echo.Context) error {
 			Image:       BaseUrl + "/static/assets/macva-1-300x71.png", // Koristi istu sliku
 			Creator:     "@MacvaNews",                                  // Opcionalno: vaš Twitter nalog
 		},
-	}
-
-	activeAds, err := server.store.ListActiveAds(ctx.Request().Context(), 11)
-	if err != nil {
-		log.Println("Error listing active ads in homePage:", err)
-		return err
+		Ads: components.AdContext{PageType: "tag"},
 	}
 
 	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
@@ -955,7 +1009,9 @@ func (server *Server) tagPage(ctx echo.Context) error {
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.TagsPage(userData, meta, activeAds, categories, tag, recentTagsComponent))
+	addPageTags(ctx, pageTagTag(tag.TagID))
+
+	return Render(ctx, http.StatusOK, components.TagsPage(userData, meta, categories, tag, recentTagsComponent))
 }
 
 func getOrCreateAnonID(c echo.Context) (uuid.UUID, error) {
@@ -982,6 +1038,11 @@ func getOrCreateAnonID(c echo.Context) (uuid.UUID, error) {
 }
 
 func (server *Server) handleViews(ctx echo.Context, contentIDStr, userIDStr string) {
+	// Views are writes too, they wait for the site to be back to normal
+	if utils.SiteModeFromContext(ctx.Request().Context()).Mode != utils.SiteModeNormal {
+		return
+	}
+
 	contentID, err := utils.ParseUUID(contentIDStr, "contentID")
 	if err != nil {
 		log.Println("Invalid contentID:", err)
@@ -1024,6 +1085,13 @@ func (server *Server) handleViews(ctx echo.Context, contentIDStr, userIDStr stri
 		if err != nil {
 			log.Println("Error incrementing view count:", err)
 		}
+		err = server.store.RecordSponsoredView(ctx.Request().Context(), db.RecordSponsoredViewParams{
+			StatDate:  pgtype.Date{Time: time.Now().In(Loc), Valid: true},
+			ContentID: contentID,
+		})
+		if err != nil {
+			log.Println("Error recording sponsored view:", err)
+		}
 		err = server.incrementDailyViews(ctx)
 		if err != nil {
 			log.Println(err)
@@ -1069,7 +1137,10 @@ func (server *Server) articlePage(ctx echo.Context) error {
 	if err != nil {
 		log.Println("Error getting user in homePage:", err)
 	}
-	server.handleViews(ctx, article.ContentID.String(), userData.UserID.String())
+	if !isPageRevalidation(ctx) {
+		server.handleViews(ctx, article.ContentID.String(), userData.UserID.String())
+	}
+	setPageView(ctx, article.ContentID)
 
 	// Prepare meta information dynamically for the search page
 	meta := components.Meta{
@@ -1090,12 +1161,7 @@ func (server *Server) articlePage(ctx echo.Context) error {
 			Image:       BaseUrl + article.Thumbnail.String, // Koristi istu sliku
 			Creator:     "@MacvaNews",                       // Opcionalno: vaš Twitter nalog
 		},
-	}
-
-	activeAds, err := server.store.ListActiveAds(ctx.Request().Context(), 11)
-	if err != nil {
-		log.Println("Error listing active ads in homePage:", err)
-		return err
+		Ads: components.AdContext{PageType: "article", ContentID: article.ContentID.String()},
 	}
 
 	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
@@ -1104,11 +1170,12 @@ func (server *Server) articlePage(ctx echo.Context) error {
 		return err
 	}
 
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error getting global settings in homePage:", err)
+		log.Println("Error getting engagement policy in articlePage:", err)
 		return err
 	}
+	policy.apply(article.ContentID, article.CategoryID, article.PublishedAt, &article.CommentsEnabled, &article.LikeCountEnabled, &article.DislikeCountEnabled, &article.ViewCountEnabled)
 
 	userReaction := ""
 	if userData.UserID.Valid {
@@ -1129,7 +1196,15 @@ func (server *Server) articlePage(ctx echo.Context) error {
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.ArticlePage(userData, meta, activeAds, categories, article, globalSettings[0], userReaction, activeAds, meta.Canonical, prerenderedArticleMediaSliderComponent))
+	// A comment permalink opens the article on that comment's thread
+	threadCommentID := ""
+	if commentID, err := utils.ParseUUID(ctx.QueryParam("komentar"), "comment ID"); err == nil {
+		threadCommentID = commentID.String()
+	}
+
+	addPageTags(ctx, pageContentTag(article.ContentID), pageGlobalSettingsTag)
+
+	return Render(ctx, http.StatusOK, components.ArticlePage(userData, meta, categories, article, policy.global, userReaction, meta.Canonical, prerenderedArticleMediaSliderComponent, threadCommentID))
 }
 
 func (server *Server) userSettingsPage(ctx echo.Context) error {
@@ -1138,10 +1213,18 @@ func (server *Server) userSettingsPage(ctx echo.Context) error {
 		log.Println("Error getting user in userSettingsPage:", err)
 	}
 
+	notificationSettings, err := server.store.GetNotificationSettings(ctx.Request().Context(), userData.UserID)
+	if err != nil {
+		log.Println("Error getting notification settings in userSettingsPage:", err)
+		return err
+	}
+
 	userProps := components.UserSettingsProps{
-		UserID:   userData.UserID.String(),
-		Username: userData.Username,
-		Pfp:      userData.Pfp,
+		UserID:        userData.UserID.String(),
+		Username:      userData.Username,
+		Pfp:           userData.Pfp,
+		EmailReplies:  notificationSettings.EmailReplies,
+		EmailMentions: notificationSettings.EmailMentions,
 	}
 
 	// Prepare meta information dynamically for the search page
@@ -1165,17 +1248,11 @@ func (server *Server) userSettingsPage(ctx echo.Context) error {
 		},
 	}
 
-	activeAds, err := server.store.ListActiveAds(ctx.Request().Context(), 11)
-	if err != nil {
-		log.Println("Error listing active ads in homePage:", err)
-		return err
-	}
-
 	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
 	if err != nil {
 		log.Println("Error listing categories in homePage:", err)
 		return err
 	}
 
-	return Render(ctx, http.StatusOK, components.UserSettingsPage(userData, meta, activeAds, categories, userProps))
+	return Render(ctx, http.StatusOK, components.UserSettingsPage(userData, meta, categories, userProps))
 }
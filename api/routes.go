@@ -13,6 +13,7 @@ func (server *Server) setupRouter() {
 	router := echo.New()
 
 	router.Use(middleware.Gzip())
+	router.Use(server.siteModeMiddleware)
 
 	/*router.Use(middleware.SecureWithConfig(middleware.SecureConfig{
 		ContentSecurityPolicy: "default-src 'self'; img-src 'self' https: data:; style-src 'self' 'unsafe-inline'; script-src 'self' https://cdn.jsdelivr.net 'unsafe-inline' 'unsafe-eval';",
@@ -73,12 +74,21 @@ func (server *Server) setupRouter() {
 	// Run cron job to create daily analytics
 	go server.scheduleDailyAnalytics()
 
-	// Run cron job to deactivate expired ads
-	go server.deactivateAds()
+	// Run cron job to publish the comments of expired shadow-bans
+	go server.scheduleShadowBanExpiry()
+
+	// Run cron job to move ads through their flight states
+	go server.scheduleAdLifecycle()
+
+	// Hash images uploaded before duplicate detection existed
+	go server.hashExistingImages()
 
 	// Serve static files
 	router.Static("/static", "static")
 
+	// Serve resized static images, see utils.ImageURL
+	router.GET("/img/:sig/:size/:fit/*", server.resizeImage)
+
 	if os.Getenv("DEV_MODE") == "true" {
 		router.Use(utils.NoCacheMiddleware)
 	}
@@ -90,6 +100,9 @@ func (server *Server) setupRouter() {
 	adminRoutes := router.Group("")
 	adminRoutes.Use(server.adminMiddleware(server.tokenMaker))
 
+	advertiserRoutes := router.Group("")
+	advertiserRoutes.Use(server.roleMiddleware(server.tokenMaker, roleAdvertiser))
+
 	// ==== Page Routes (No rate limiting) ====
 
 	// Admin Page Routes - no rate limiting for page views
@@ -99,6 +112,7 @@ func (server *Server) setupRouter() {
 	adminRoutes.GET("/admin/create-cat-form", server.createCategoryForm)
 	adminRoutes.GET("/admin/delete-cat-modal/:id", server.deleteCategoryModal)
 	adminRoutes.GET("/admin/update-cat-form/:id", server.updateCategoryForm)
+	adminRoutes.GET("/admin/category-rules/:id", server.categoryRulesModal)
 	adminRoutes.GET("/admin/content", server.adminArts)
 	adminRoutes.GET("/admin/content/create", server.createArticlePage)
 	adminRoutes.GET("/admin/content/update/:id", server.updateArticlePage)
@@ -113,9 +127,17 @@ func (server *Server) setupRouter() {
 	adminRoutes.GET("/admin/active-ads", server.activeAdsList)
 	adminRoutes.GET("/admin/inactive-ads", server.inactiveAdsList)
 	adminRoutes.GET("/admin/scheduled-ads", server.scheduledAdsList)
+	adminRoutes.GET("/admin/archived-ads", server.archivedAdsList)
+	adminRoutes.GET("/admin/ad-stats", server.adStats)
+	adminRoutes.GET("/admin/ad-placements", server.adminAdPlacements)
+	adminRoutes.GET("/admin/ad-placement-modal", server.createAdPlacementModal)
+	adminRoutes.GET("/admin/ad-placement-modal/:key", server.updateAdPlacementModal)
 	adminRoutes.GET("/admin/create-ad-modal", server.createAdModal)
 	adminRoutes.GET("/admin/update-ad-modal/:id", server.updateAdModal)
+	adminRoutes.GET("/admin/ad-advertisers/:id", server.adAdvertisers)
 	adminRoutes.GET("/admin/settings", server.adminSettings)
+	adminRoutes.GET("/admin/media/duplicates", server.adminMediaDuplicates)
+	adminRoutes.GET("/admin/comments/held", server.adminHeldComments)
 
 	// Auth Pages - no rate limiting for page views
 	router.GET("/login", server.loginPage)
@@ -125,13 +147,20 @@ func (server *Server) setupRouter() {
 	router.GET("/potvrdi-email/:token", server.emailVerifiedPage)
 
 	// User Page Routes - no rate limiting for page views
-	router.GET("/", server.homePage)
+	// Logged out readers get these from the page cache, see page_cache.go
+	router.GET("/", server.homePage, server.pageCacheMiddleware)
 	router.GET("/pretraga", server.searchResultsPage)
-	router.GET("/kategorije/:slug", server.categoriesPage)
-	router.GET("/oznake/:slug", server.tagPage)
+	router.GET("/kategorije/:slug", server.categoriesPage, server.pageCacheMiddleware)
+	router.GET("/oznake/:slug", server.tagPage, server.pageCacheMiddleware)
 	//router.GET("/:article/:id", server.articlePage)
-	router.GET("/:year/:month/:slug", server.articlePage)
+	router.GET("/:year/:month/:slug", server.articlePage, server.pageCacheMiddleware)
+	router.GET("/komentar/:id", server.commentPermalink)
 	authRoutes.GET("/podesavanja", server.userSettingsPage)
+	authRoutes.GET("/obavestenja", server.notificationsPage)
+	authRoutes.GET("/obavestenja/:id", server.openNotification)
+	advertiserRoutes.GET("/oglasivac", server.advertiserPortal)
+	advertiserRoutes.GET("/oglasivac/izvestaj.csv", server.advertiserStatementCSV)
+	advertiserRoutes.GET("/oglasivac/izvestaj.pdf", server.advertiserStatementPDF)
 
 	// ==== API Routes with Rate Limiting ====
 
@@ -159,6 +188,8 @@ func (server *Server) setupRouter() {
 	commentApiRoutes.GET("/content/comments/:id/score", server.listContentCommentsScore)
 	commentApiRoutes.GET("/comments/:id/reply-info", server.listRepliesInfo)
 	commentApiRoutes.GET("/comments/:id/more-replies", server.listCommentReplies)
+	commentApiRoutes.GET("/comments/:id/thread", server.listCommentThread)
+	commentApiRoutes.GET("/content/comments/:id/live", server.liveComments)
 
 	// Comment write routes - require auth
 	commentAuthRoutes := authRoutes.Group("/api")
@@ -177,7 +208,22 @@ func (server *Server) setupRouter() {
 
 	contentApiRoutes.POST("/content/like/:id", server.handleLikeContent)
 	contentApiRoutes.POST("/content/dislike/:id", server.handleDislikeContent)
-	router.POST("/api/increment-ads-clicks", server.incrementDailyAdsClicks) // Public route
+
+	// ---- Ad Tracking API (Moderate Limiting) ----
+	// Public, repeated impressions of a visitor count once a day
+	adTrackingRoutes := router.Group("/api/ads")
+	adTrackingRoutes.Use(server.RateLimitMiddleware(contentLimiter))
+
+	adTrackingRoutes.POST("/:id/impression", server.recordAdImpression)
+
+	// Ads are picked per request, so this is never cached
+	router.GET("/api/ads/slots", server.adSlots)
+
+	// Signed ad links count the click and redirect to the advertiser
+	router.GET("/go/ad/:id", server.adRedirect)
+
+	// Signed links of the reports shared with sponsors
+	router.GET("/sponzorisano/:id", server.sponsorReport)
 
 	// ---- User Settings API (Moderate Limiting) ----
 	userSettingsRoutes := authRoutes.Group("/api/admin/settings")
@@ -186,6 +232,15 @@ func (server *Server) setupRouter() {
 	userSettingsRoutes.PUT("/username/:id", server.updateUsername)
 	userSettingsRoutes.PUT("/pfp/:id", server.updatePfp)
 
+	// ---- Notifications API ----
+	// The header polls the unread count, so it isn't rate limited
+	authRoutes.GET("/api/notifications/unread", server.unreadNotifications)
+	notificationRoutes := authRoutes.Group("/api")
+	notificationRoutes.Use(server.RateLimitMiddleware(userSettingsLimiter))
+
+	notificationRoutes.POST("/notifications/read-all", server.markAllNotificationsRead)
+	notificationRoutes.PUT("/notification-settings", server.updateNotificationSettings)
+
 	// Cookie deletion
 	authRoutes.DELETE("/api/cookie", server.deleteCookie)
 
@@ -220,6 +275,9 @@ func (server *Server) setupRouter() {
 	adminApiRoutes.POST("/category", server.createCategory)
 	adminApiRoutes.DELETE("/category/:id", server.deleteCategory)
 	adminApiRoutes.PUT("/category/:id", server.updateCategory)
+	adminApiRoutes.GET("/engagement/:scope/:id", server.engagementRules)
+	adminApiRoutes.POST("/engagement/:scope/:id", server.setEngagementRule)
+	adminApiRoutes.DELETE("/engagement/rule/:id", server.deleteEngagementRule)
 
 	// Admin articles
 	adminApiRoutes.GET("/content/published", server.listPubContent)
@@ -239,6 +297,7 @@ func (server *Server) setupRouter() {
 	adminApiRoutes.PUT("/content/publish/:id", server.publishDraftContent)
 	adminApiRoutes.PUT("/content/unarchive/:id", server.unarchiveContent)
 	adminApiRoutes.PUT("/content/:id", server.updateContent)
+	adminApiRoutes.PUT("/content/:id/sponsor", server.updateContentSponsor)
 	adminApiRoutes.POST("/content/draft", server.createContent)
 	adminApiRoutes.POST("/content/publish", server.createAndPublishContent)
 
@@ -246,6 +305,10 @@ func (server *Server) setupRouter() {
 	adminApiRoutes.GET("/media", server.listMediaForContent)
 	adminApiRoutes.POST("/media/upload/new", server.addMediaToNewContent)
 	adminApiRoutes.POST("/media/upload/:id", server.addMediaToUpdateContent)
+	adminApiRoutes.PUT("/media/:id", server.updateMediaCaption)
+	adminApiRoutes.PUT("/media/:id/focal", server.updateMediaFocalPoint)
+	adminApiRoutes.PUT("/media/:id/reuse/:existing_id", server.reuseMedia)
+	adminApiRoutes.POST("/media/duplicates/merge", server.mergeDuplicateMedia)
 	adminApiRoutes.DELETE("/media/remove/:id", server.deleteMedia)
 
 	// Admin Tags
@@ -275,18 +338,37 @@ func (server *Server) setupRouter() {
 	adminApiRoutes.PUT("/users/ban/:id", server.banUser)
 	adminApiRoutes.PUT("/users/unban/:id", server.unbanUser)
 	adminApiRoutes.PUT("/users/archive/:id", server.deleteUser)
+	adminApiRoutes.GET("/users/:id/sanctions", server.userSanctionsPanel)
+	adminApiRoutes.POST("/users/:id/sanctions", server.createUserSanction)
+	adminApiRoutes.PUT("/sanctions/:id/lift", server.liftUserSanction)
 
 	// Admin settings
 	adminApiRoutes.PUT("/global-settings", server.updateGlobalSettings)
+	adminApiRoutes.PUT("/site-mode", server.updateSiteMode)
 	adminApiRoutes.PUT("/reset-global-settings", server.resetGlobalSettings)
+	adminApiRoutes.PUT("/watermark-settings", server.updateWatermarkSettings)
+	adminApiRoutes.POST("/watermark-settings/regenerate", server.regenerateWatermarks)
+	adminApiRoutes.PUT("/comment-settings", server.updateCommentSettings)
+	adminApiRoutes.GET("/comments/held-count", server.heldCommentsCount)
+	adminApiRoutes.GET("/comments/:id/revisions", server.listCommentRevisions)
+	adminApiRoutes.PUT("/comments/:id/approve", server.approveHeldComment)
+	adminApiRoutes.DELETE("/comments/:id/reject", server.rejectHeldComment)
 
 	// Admin ads
 	adminApiRoutes.GET("/ads/active", server.listActiveAds)
 	adminApiRoutes.GET("/ads/inactive", server.listInactiveAds)
+	adminApiRoutes.GET("/ads/archived", server.listArchivedAds)
 	adminApiRoutes.POST("/ads", server.createAd)
 	adminApiRoutes.DELETE("/ads/:id", server.deleteAd)
 	adminApiRoutes.PUT("/ads/:id", server.updateAd)
 	adminApiRoutes.PUT("/ads/deactivate/:id", server.deactivateAd)
+	adminApiRoutes.PUT("/ads/extend/:id", server.extendAd)
+	adminApiRoutes.GET("/ads/stats/export", server.exportAdStats)
+	adminApiRoutes.POST("/ads/placements", server.createAdPlacement)
+	adminApiRoutes.PUT("/ads/placements/:key", server.updateAdPlacement)
+	adminApiRoutes.DELETE("/ads/placements/:key", server.deleteAdPlacement)
+	adminApiRoutes.POST("/ads/:id/advertisers", server.addAdAdvertiser)
+	adminApiRoutes.DELETE("/ads/:id/advertisers/:user_id", server.removeAdAdvertiser)
 
 	server.router = router
 }
@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Kinds of user sanctions. A ban also sets the user's banned flag, the
+// sanction only keeps its reason.
+const (
+	sanctionBan        = "ban"
+	sanctionSuspension = "suspension"
+	sanctionMute       = "mute"
+	sanctionShadowBan  = "shadowban"
+)
+
+const userSanctionsKey = "user_sanctions"
+
+// userSanctionsLimit caps the sanction history shown to moderators
+const userSanctionsLimit = 20
+
+// shadowedCommentsLimit caps the hidden comments shown back to their author
+const shadowedCommentsLimit = 20
+
+// userSanctions are the sanctions in force against a user, the newest of each kind
+type userSanctions struct {
+	Ban        *db.UserSanction
+	Suspension *db.UserSanction
+	Mute       *db.UserSanction
+	ShadowBan  *db.UserSanction
+}
+
+// getUserSanctions returns the sanctions in force. Expired sanctions are
+// ignored, so suspensions and mutes end on their own.
+func (server *Server) getUserSanctions(ctx context.Context, userID pgtype.UUID) (userSanctions, error) {
+	var sanctions userSanctions
+
+	active, err := server.store.ListActiveUserSanctions(ctx, userID)
+	if err != nil {
+		return sanctions, err
+	}
+
+	for i := range active {
+		sanction := &active[i]
+
+		var slot **db.UserSanction
+		switch sanction.Kind {
+		case sanctionBan:
+			slot = &sanctions.Ban
+		case sanctionSuspension:
+			slot = &sanctions.Suspension
+		case sanctionMute:
+			slot = &sanctions.Mute
+		case sanctionShadowBan:
+			slot = &sanctions.ShadowBan
+		default:
+			continue
+		}
+		if *slot == nil {
+			*slot = sanction
+		}
+	}
+
+	return sanctions, nil
+}
+
+// sanctionsFromContext returns the sanctions authMiddleware found for the
+// signed in user.
+func sanctionsFromContext(ctx echo.Context) userSanctions {
+	sanctions, _ := ctx.Get(userSanctionsKey).(userSanctions)
+	return sanctions
+}
+
+// lockedMessage returns why the user can't use their account, or "" if they can.
+func (s userSanctions) lockedMessage(banned bool) string {
+	if banned {
+		return withSanctionReason("Vaš nalog je blokiran.", s.Ban)
+	}
+	if s.Suspension != nil {
+		return withSanctionReason(fmt.Sprintf("Vaš nalog je suspendovan %s.", sanctionUntil(s.Suspension)), s.Suspension)
+	}
+	return ""
+}
+
+// muteMessage returns why the user can't comment, or "" if they can.
+func (s userSanctions) muteMessage() string {
+	if s.Mute == nil {
+		return ""
+	}
+	return withSanctionReason(fmt.Sprintf("Komentarisanje vam je onemogućeno %s.", sanctionUntil(s.Mute)), s.Mute)
+}
+
+func sanctionUntil(sanction *db.UserSanction) string {
+	if !sanction.ExpiresAt.Valid {
+		return "do daljnjeg"
+	}
+	return "do " + sanction.ExpiresAt.Time.In(Loc).Format("02.01.2006. 15:04")
+}
+
+func withSanctionReason(message string, sanction *db.UserSanction) string {
+	if sanction == nil || sanction.Reason == "" {
+		return message
+	}
+	return message + " Razlog: " + sanction.Reason
+}
+
+// withShadowedComments puts the signed in user's hidden comments above the
+// comments everyone sees, so a shadow-banned user doesn't notice the ban.
+func (server *Server) withShadowedComments(ctx context.Context, comments []db.ListContentCommentsRow, user db.GetUserByIDRow, contentID, parentCommentID pgtype.UUID) []db.ListContentCommentsRow {
+	if !user.UserID.Valid {
+		return comments
+	}
+
+	shadowed, err := server.store.ListShadowedComments(ctx, db.ListShadowedCommentsParams{
+		ContentID:       contentID,
+		UserID:          user.UserID,
+		ParentCommentID: parentCommentID,
+		Limit:           shadowedCommentsLimit,
+	})
+	if err != nil {
+		log.Println("Error listing shadowed comments in withShadowedComments:", err)
+		return comments
+	}
+	if len(shadowed) == 0 {
+		return comments
+	}
+
+	merged := make([]db.ListContentCommentsRow, 0, len(shadowed)+len(comments))
+	for _, comment := range shadowed {
+		merged = append(merged, db.ListContentCommentsRow(comment))
+	}
+
+	return append(merged, comments...)
+}
+
+func (server *Server) userSanctionsPanel(ctx echo.Context) error {
+	userID, err := utils.ParseUUID(ctx.Param("id"), "user_id")
+	if err != nil {
+		log.Println("Error parsing user id in userSanctionsPanel:", err)
+		return err
+	}
+
+	return server.renderUserSanctions(ctx, userID, "")
+}
+
+type CreateUserSanctionReq struct {
+	Kind          string `form:"kind" validate:"required,oneof=suspension mute shadowban"`
+	DurationHours int    `form:"duration_hours" validate:"gte=0,lte=8760"`
+	Reason        string `form:"reason" validate:"required,max=500"`
+}
+
+// createUserSanction suspends, mutes or shadow-bans a user. A duration of
+// zero hours lasts until a moderator lifts the sanction.
+func (server *Server) createUserSanction(ctx echo.Context) error {
+	var req CreateUserSanctionReq
+
+	userID, err := utils.ParseUUID(ctx.Param("id"), "user_id")
+	if err != nil {
+		log.Println("Error parsing user id in createUserSanction:", err)
+		return err
+	}
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in createUserSanction:", err)
+		return err
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		log.Println("Error validating request in createUserSanction:", err)
+		return server.renderUserSanctions(ctx, userID, "Izaberite vrstu sankcije i unesite razlog (najviše 500 karaktera).")
+	}
+
+	var expiresAt pgtype.Timestamptz
+	if req.DurationHours > 0 {
+		expiresAt = pgtype.Timestamptz{Time: time.Now().Add(time.Duration(req.DurationHours) * time.Hour), Valid: true}
+	}
+
+	_, err = server.store.CreateUserSanction(ctx.Request().Context(), db.CreateUserSanctionParams{
+		UserID:    userID,
+		Kind:      req.Kind,
+		Reason:    req.Reason,
+		ExpiresAt: expiresAt,
+		CreatedBy: moderatorID(ctx),
+	})
+	if err != nil {
+		log.Println("Error creating user sanction in createUserSanction:", err)
+		return err
+	}
+
+	return server.renderUserSanctions(ctx, userID, "")
+}
+
+// liftUserSanction lifts a sanction. The comments a user posted under their
+// last shadow-ban are published with it.
+func (server *Server) liftUserSanction(ctx echo.Context) error {
+	sanctionID, err := utils.ParseUUID(ctx.Param("id"), "sanction_id")
+	if err != nil {
+		log.Println("Error parsing sanction id in liftUserSanction:", err)
+		return err
+	}
+
+	result, err := server.store.LiftUserSanctionTx(ctx.Request().Context(), sanctionID)
+	if err != nil {
+		log.Println("Error lifting user sanction in liftUserSanction:", err)
+		return err
+	}
+
+	server.invalidateUnshadowedComments(ctx.Request().Context(), result.Unshadowed)
+
+	return server.renderUserSanctions(ctx, result.Sanction.UserID, "")
+}
+
+// unshadowExpiredComments publishes the comments posted under shadow-bans
+// that have since expired.
+func (server *Server) unshadowExpiredComments(ctx context.Context) {
+	comments, err := server.store.UnshadowComments(ctx, pgtype.UUID{})
+	if err != nil {
+		log.Println("Error unshadowing comments in unshadowExpiredComments:", err)
+		return
+	}
+
+	server.invalidateUnshadowedComments(ctx, comments)
+}
+
+// invalidateUnshadowedComments drops the cached lists and counts that now
+// show the published comments.
+func (server *Server) invalidateUnshadowedComments(ctx context.Context, comments []db.UnshadowCommentsRow) {
+	for _, comment := range comments {
+		server.invalidateCommentCache(ctx, comment.ContentID, comment.ParentCommentID, comment.CommentID)
+	}
+}
+
+func (server *Server) renderUserSanctions(ctx echo.Context, userID pgtype.UUID, message string) error {
+	user, err := server.store.GetUserByID(ctx.Request().Context(), userID)
+	if err != nil {
+		log.Println("Error getting user in renderUserSanctions:", err)
+		return err
+	}
+
+	sanctions, err := server.store.ListUserSanctions(ctx.Request().Context(), db.ListUserSanctionsParams{
+		UserID: userID,
+		Limit:  userSanctionsLimit,
+	})
+	if err != nil {
+		log.Println("Error listing user sanctions in renderUserSanctions:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.UserSanctions(user, sanctions, message))
+}
+
+// moderatorID returns the signed in admin, recorded as the author of a sanction.
+func moderatorID(ctx echo.Context) pgtype.UUID {
+	return currentUserID(ctx)
+}
@@ -3,12 +3,16 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"os"
+	"runtime"
 
 	"github.com/00mark0/macva-press/db/redis"
 	"github.com/00mark0/macva-press/db/services"
 	"github.com/00mark0/macva-press/token"
+	"github.com/00mark0/macva-press/utils"
 	"github.com/labstack/echo/v4"
 	redisClient "github.com/redis/go-redis/v9"
 )
@@ -22,6 +26,12 @@ type Server struct {
 	cacheService    *redis.CacheService // Store the cache service here
 	router          *echo.Echo
 	uploadSemaphore chan struct{}
+	resizeSemaphore chan struct{}   // Bounds concurrent on-the-fly image resizes
+	imageCache      *imageCache     // Resized images served by /img
+	watermarkJob    chan struct{}   // Held while photos are rebuilt from originals
+	commentFilters  []commentFilter // Spam and abuse checks of new and edited comments
+	liveHub         *liveHub        // Readers of live comment updates on this instance
+	siteMode        *siteModeState  // Read-only or maintenance, switched from the admin settings
 }
 
 // NewServer creates an HTTP server and sets up routing.
@@ -34,11 +44,32 @@ func NewServer(store *db.Store, symmetricKey string, redisClient *redisClient.Cl
 	// Create a CacheService instance from the redis client
 	cacheService := redis.NewCacheService(redisClient)
 
+	imageCache, err := newImageCacheFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("cannot create image cache: %w", err)
+	}
+
 	server := &Server{
-		store:        store,
-		tokenMaker:   tokenMaker,
-		cacheService: cacheService, // Pass CacheService to server
+		store:           store,
+		tokenMaker:      tokenMaker,
+		cacheService:    cacheService, // Pass CacheService to server
+		resizeSemaphore: make(chan struct{}, runtime.NumCPU()),
+		imageCache:      imageCache,
+		watermarkJob:    make(chan struct{}, 1),
+		commentFilters:  defaultCommentFilters(store),
+		liveHub:         newLiveHubFromEnv(),
+		siteMode:        &siteModeState{},
+	}
+
+	go server.liveHub.relay(cacheService)
+
+	siteMode, err := server.loadSiteMode(context.Background())
+	if err != nil {
+		log.Println("Error loading site mode in NewServer:", err)
+		siteMode = utils.SiteMode{Mode: utils.SiteModeNormal}
 	}
+	server.siteMode.set(siteMode)
+	go server.watchSiteMode()
 
 	server.setupRouter()
 
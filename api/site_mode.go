@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// The admin switches the mode in the global settings and publishes it, every
+// instance applies it as soon as it hears about it. The periodic re-sync
+// catches instances that missed the message.
+const (
+	siteModeChannel = "site_mode"
+	siteModeResync  = 30 * time.Second
+
+	// Readers come back after maintenance, not before
+	siteModeRetryAfter = "300"
+	siteModeMaxMessage = 300
+
+	siteModePath = "/api/admin/site-mode"
+)
+
+// readOnlyMessage is what a write gets in read-only mode
+const readOnlyMessage = "Sajt je trenutno u režimu samo za čitanje. Pokušajte ponovo kasnije."
+
+// siteModeState is the mode this instance serves in.
+type siteModeState struct {
+	mu   sync.RWMutex
+	mode utils.SiteMode
+}
+
+func (s *siteModeState) get() utils.SiteMode {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mode
+}
+
+// set reports whether the mode changed.
+func (s *siteModeState) set(mode utils.SiteMode) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := s.mode != mode
+	s.mode = mode
+	return changed
+}
+
+// loadSiteMode reads the mode from the global settings.
+func (server *Server) loadSiteMode(ctx context.Context) (utils.SiteMode, error) {
+	globalSettings, err := server.store.GetGlobalSettings(ctx)
+	if err != nil {
+		return utils.SiteMode{}, err
+	}
+	if len(globalSettings) == 0 {
+		return utils.SiteMode{Mode: utils.SiteModeNormal}, nil
+	}
+
+	return utils.SiteMode{
+		Mode:    globalSettings[0].SiteMode,
+		Message: globalSettings[0].SiteModeMessage,
+	}, nil
+}
+
+// applySiteMode switches this instance to mode. Cached pages carry the banner
+// of the mode they were rendered in, so they all go.
+func (server *Server) applySiteMode(ctx context.Context, mode utils.SiteMode) {
+	if !server.siteMode.set(mode) {
+		return
+	}
+
+	log.Println("Site mode is now", mode.Mode)
+	server.purgePages(ctx, pageCategoriesTag)
+}
+
+// watchSiteMode applies the modes other instances publish, and the one in the
+// database every siteModeResync. A failed re-sync keeps the current mode.
+func (server *Server) watchSiteMode() {
+	sub := server.cacheService.PSubscribe(context.Background(), siteModeChannel)
+	defer sub.Close()
+
+	ticker := time.NewTicker(siteModeResync)
+	defer ticker.Stop()
+
+	messages := sub.Channel()
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			var mode utils.SiteMode
+			if err := json.Unmarshal([]byte(msg.Payload), &mode); err != nil {
+				log.Println("Error decoding site mode in watchSiteMode:", err)
+				continue
+			}
+			server.applySiteMode(context.Background(), mode)
+		case <-ticker.C:
+			mode, err := server.loadSiteMode(context.Background())
+			if err != nil {
+				log.Println("Error loading site mode in watchSiteMode:", err)
+				continue
+			}
+			server.applySiteMode(context.Background(), mode)
+		}
+	}
+}
+
+// isAdminRequest tells from the token cookies alone whether an admin sent the
+// request, the database may be the thing under maintenance.
+func (server *Server) isAdminRequest(ctx echo.Context) bool {
+	for _, name := range []string{"access_token", "refresh_token"} {
+		cookie, err := ctx.Cookie(name)
+		if err != nil {
+			continue
+		}
+		payload, err := server.tokenMaker.VerifyToken(cookie.Value)
+		if err == nil && payload.Role == "admin" {
+			return true
+		}
+	}
+	return false
+}
+
+// siteModeAllowed are the requests every mode lets through, so assets load
+// and admins can log in, switch the mode back and log out.
+func siteModeAllowed(req *http.Request) bool {
+	path := req.URL.Path
+	switch {
+	case strings.HasPrefix(path, "/static/"), strings.HasPrefix(path, "/img/"):
+		return true
+	case path == "/login", path == "/api/login", path == "/api/logout", path == siteModePath:
+		return true
+	}
+	return false
+}
+
+// siteModeMiddleware serves the maintenance page to everyone but admins, and
+// in read-only mode turns down every write. Pages see the mode through
+// utils.SiteModeFromContext to show the banner.
+func (server *Server) siteModeMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		mode := server.siteMode.get()
+		if mode.Mode == utils.SiteModeNormal {
+			return next(ctx)
+		}
+
+		req := ctx.Request()
+		ctx.SetRequest(req.WithContext(utils.WithSiteMode(req.Context(), mode)))
+
+		if siteModeAllowed(req) {
+			return next(ctx)
+		}
+
+		switch mode.Mode {
+		case utils.SiteModeMaintenance:
+			if server.isAdminRequest(ctx) {
+				return next(ctx)
+			}
+
+			ctx.Response().Header().Set("Retry-After", siteModeRetryAfter)
+			if req.Header.Get("HX-Request") == "true" {
+				// Swapping the page into a fragment would break the layout
+				ctx.Response().Header().Set("HX-Refresh", "true")
+			}
+			return Render(ctx, http.StatusServiceUnavailable, components.MaintenancePage(mode.Message))
+		case utils.SiteModeReadOnly:
+			if slices.Contains([]string{http.MethodGet, http.MethodHead, http.MethodOptions}, req.Method) {
+				return next(ctx)
+			}
+
+			if req.Header.Get("HX-Request") == "true" {
+				ctx.Response().Header().Set("HX-Retarget", "#user-modal")
+				return Render(ctx, http.StatusOK, components.InfoWarning(readOnlyMessage))
+			}
+			return echo.NewHTTPError(http.StatusServiceUnavailable, readOnlyMessage)
+		}
+
+		return next(ctx)
+	}
+}
+
+type SiteModeReq struct {
+	SiteMode        string `form:"site_mode"`
+	SiteModeMessage string `form:"site_mode_message"`
+}
+
+// updateSiteMode switches every instance to the chosen mode. Without Redis
+// the others pick it up on their next re-sync.
+func (server *Server) updateSiteMode(ctx echo.Context) error {
+	var req SiteModeReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in updateSiteMode:", err)
+		return err
+	}
+
+	req.SiteModeMessage = strings.TrimSpace(req.SiteModeMessage)
+
+	if !slices.Contains([]string{utils.SiteModeNormal, utils.SiteModeReadOnly, utils.SiteModeMaintenance}, req.SiteMode) {
+		return Render(ctx, http.StatusOK, components.InfoWarning("Izaberite režim rada."))
+	}
+	if utf8.RuneCountInString(req.SiteModeMessage) > siteModeMaxMessage {
+		return Render(ctx, http.StatusOK, components.InfoWarning("Poruka može imati najviše 300 karaktera."))
+	}
+
+	err := server.store.UpdateSiteMode(ctx.Request().Context(), db.UpdateSiteModeParams{
+		SiteMode:        req.SiteMode,
+		SiteModeMessage: req.SiteModeMessage,
+	})
+	if err != nil {
+		log.Println("Error updating site mode in updateSiteMode:", err)
+		return err
+	}
+
+	mode := utils.SiteMode{Mode: req.SiteMode, Message: req.SiteModeMessage}
+
+	payload, err := json.Marshal(mode)
+	if err != nil {
+		log.Println("Error encoding site mode in updateSiteMode:", err)
+		return err
+	}
+	if err := server.cacheService.Publish(ctx.Request().Context(), siteModeChannel, payload); err != nil {
+		log.Println("Error publishing site mode in updateSiteMode:", err)
+	}
+
+	// This instance doesn't wait for its own message
+	server.applySiteMode(ctx.Request().Context(), mode)
+
+	return Render(ctx, http.StatusOK, components.UpdateSuccess("Režim rada je sačuvan."))
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// The classifier keeps quiet until moderators have judged spamMinTraining
+// comments of each kind, and holds comments it rates spamThreshold or higher.
+const (
+	spamMinTraining = 20
+	spamThreshold   = 0.95
+	spamMaxTokens   = 200
+)
+
+// spamClassifier is a naive Bayes filter over the words of a comment, trained
+// by moderators approving and rejecting held comments.
+type spamClassifier struct {
+	store *db.Store
+}
+
+// spamTokens returns the distinct words of a comment the classifier looks at.
+func spamTokens(text string) []string {
+	var tokens []string
+	seen := make(map[string]bool)
+
+	for _, token := range utils.Tokenize(text) {
+		if len([]rune(token)) < 2 || seen[token] {
+			continue
+		}
+		seen[token] = true
+		tokens = append(tokens, token)
+		if len(tokens) == spamMaxTokens {
+			break
+		}
+	}
+
+	return tokens
+}
+
+// spamProbability returns how likely the comment is spam, and false while the
+// classifier hasn't seen enough moderator decisions to tell.
+func (c *spamClassifier) spamProbability(ctx context.Context, text string) (float64, bool, error) {
+	counts, err := c.store.GetSpamTrainingCounts(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if counts.SpamCount < spamMinTraining || counts.HamCount < spamMinTraining {
+		return 0, false, nil
+	}
+
+	tokens := spamTokens(text)
+	if len(tokens) == 0 {
+		return 0, false, nil
+	}
+
+	known, err := c.store.GetSpamTokens(ctx, tokens)
+	if err != nil {
+		return 0, false, err
+	}
+	stats := make(map[string]db.SpamToken, len(known))
+	for _, token := range known {
+		stats[token.Token] = token
+	}
+
+	spamDocs := float64(counts.SpamCount)
+	hamDocs := float64(counts.HamCount)
+
+	// Log odds with Laplace smoothing, so unseen words count for neither side
+	logSpam := math.Log(spamDocs / (spamDocs + hamDocs))
+	logHam := math.Log(hamDocs / (spamDocs + hamDocs))
+	for _, token := range tokens {
+		s := stats[token]
+		logSpam += math.Log((float64(s.SpamCount) + 1) / (spamDocs + 2))
+		logHam += math.Log((float64(s.HamCount) + 1) / (hamDocs + 2))
+	}
+
+	return 1 / (1 + math.Exp(logHam-logSpam)), true, nil
+}
+
+func (c *spamClassifier) check(ctx context.Context, comment commentCheck) (string, error) {
+	probability, ok, err := c.spamProbability(ctx, comment.Text)
+	if err != nil || !ok {
+		return "", err
+	}
+	if probability >= spamThreshold {
+		return fmt.Sprintf("Verovatno spam (%.0f%%)", probability*100), nil
+	}
+	return "", nil
+}
+
+// trainSpamClassifier teaches the classifier a moderator decision. Each
+// comment is learned once, whatever the moderator does with it later.
+func (server *Server) trainSpamClassifier(ctx context.Context, commentID pgtype.UUID, text string, isSpam bool) error {
+	created, err := server.store.CreateSpamTraining(ctx, db.CreateSpamTrainingParams{
+		CommentID: commentID,
+		IsSpam:    isSpam,
+	})
+	if err != nil || created == 0 {
+		return err
+	}
+
+	tokens := spamTokens(text)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	return server.store.TrainSpamTokens(ctx, db.TrainSpamTokensParams{
+		IsSpam: isSpam,
+		Tokens: tokens,
+	})
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+type SponsorReq struct {
+	IsSponsored bool   `form:"is_sponsored"`
+	SponsorName string `form:"sponsor_name"`
+	SponsorLogo string `form:"sponsor_logo"`
+}
+
+// sponsorParams checks the sponsor of an article, a sponsored article must
+// name its sponsor. The message is for the admin when it doesn't pass.
+func sponsorParams(contentID pgtype.UUID, req SponsorReq) (db.UpdateContentSponsorParams, string) {
+	arg := db.UpdateContentSponsorParams{ContentID: contentID, IsSponsored: req.IsSponsored}
+	if !req.IsSponsored {
+		return arg, ""
+	}
+
+	name := strings.TrimSpace(req.SponsorName)
+	logo := strings.TrimSpace(req.SponsorLogo)
+
+	if name == "" {
+		return arg, "Naziv sponzora je obavezan za sponzorisani sadržaj."
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return arg, "Naziv sponzora može imati najviše 100 karaktera."
+	}
+	if logo != "" && !strings.HasPrefix(logo, "/") && !strings.HasPrefix(logo, "https://") {
+		return arg, "Logo sponzora mora biti link na sliku (https:// ili iz medija)."
+	}
+
+	arg.SponsorName = pgtype.Text{String: name, Valid: true}
+	arg.SponsorLogo = pgtype.Text{String: logo, Valid: logo != ""}
+
+	return arg, ""
+}
+
+// sponsorReportURL is the link of the article's report the admin shares
+// with the sponsor, valid for utils.SponsorReportTTL.
+func sponsorReportURL(contentID string) string {
+	path := utils.SponsorReportPath(contentID, time.Now().Add(utils.SponsorReportTTL))
+	if path == "" {
+		return ""
+	}
+
+	return BaseUrl + path
+}
+
+// updateContentSponsor marks an article as paid, or no longer paid.
+func (server *Server) updateContentSponsor(ctx echo.Context) error {
+	contentID, err := utils.ParseUUID(ctx.Param("id"), "content ID")
+	if err != nil {
+		log.Println("Invalid content ID format in updateContentSponsor:", err)
+		return err
+	}
+
+	var req SponsorReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in updateContentSponsor:", err)
+		return err
+	}
+
+	reportURL := sponsorReportURL(contentID.String())
+
+	arg, message := sponsorParams(contentID, req)
+	if message != "" {
+		return Render(ctx, http.StatusOK, components.ArticleSponsorForm(contentID.String(), req.IsSponsored, req.SponsorName, req.SponsorLogo, reportURL, message))
+	}
+
+	if err := server.store.UpdateContentSponsor(ctx.Request().Context(), arg); err != nil {
+		log.Println("Error updating content sponsor in updateContentSponsor:", err)
+		return err
+	}
+
+	// The label shows on every page that lists the article
+	server.purgePages(ctx.Request().Context(), server.contentPageTags(ctx.Request().Context(), contentID)...)
+
+	return Render(ctx, http.StatusOK, components.ArticleSponsorForm(contentID.String(), arg.IsSponsored, arg.SponsorName.String, arg.SponsorLogo.String, reportURL, ""))
+}
+
+// sponsorReport is the month's report of a sponsored article, opened from
+// the signed link the sponsor was given until it expires.
+func (server *Server) sponsorReport(ctx echo.Context) error {
+	contentIDStr := ctx.Param("id")
+
+	exp, err := strconv.ParseInt(ctx.QueryParam("exp"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusForbidden, "Invalid report link")
+	}
+	expires := time.Unix(exp, 0)
+	if !utils.VerifySponsorReportSignature(ctx.QueryParam("sig"), contentIDStr, expires) {
+		return echo.NewHTTPError(http.StatusForbidden, "Invalid or expired report link")
+	}
+
+	contentID, err := utils.ParseUUID(contentIDStr, "content ID")
+	if err != nil {
+		log.Println("Invalid content ID format in sponsorReport:", err)
+		return err
+	}
+
+	var req AdvertiserReportReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in sponsorReport:", err)
+		return err
+	}
+
+	content, err := server.store.GetContentDetails(ctx.Request().Context(), contentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "Article not found")
+		}
+		log.Println("Error getting content details in sponsorReport:", err)
+		return err
+	}
+	if !content.IsSponsored {
+		return echo.NewHTTPError(http.StatusNotFound, "Article is not sponsored")
+	}
+
+	from, to := advertiserMonth(req)
+
+	stats, err := server.store.ListSponsoredDailyStats(ctx.Request().Context(), db.ListSponsoredDailyStatsParams{
+		ContentID: contentID,
+		StartDate: pgtype.Date{Time: from, Valid: true},
+		EndDate:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		log.Println("Error listing sponsored daily stats in sponsorReport:", err)
+		return err
+	}
+
+	report := components.SponsorReport{
+		Month:   from,
+		Content: content,
+		URL:     utils.SponsorReportPath(contentIDStr, expires),
+	}
+
+	// Days without views are kept so the chart has no gaps
+	views := make(map[string]int32)
+	for _, stat := range stats {
+		views[stat.StatDate.Time.Format("2006-01-02")] = stat.Views
+		report.Views += stat.Views
+	}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		report.Days = append(report.Days, components.AdvertiserDay{Date: day, Impressions: views[day.Format("2006-01-02")]})
+	}
+
+	userData, err := server.getUserFromCacheOrDb(ctx, "refresh_token")
+	if err != nil {
+		log.Println("Error getting user in sponsorReport:", err)
+	}
+
+	categories, err := server.store.ListCategories(ctx.Request().Context(), 1000)
+	if err != nil {
+		log.Println("Error listing categories in sponsorReport:", err)
+		return err
+	}
+
+	meta := components.Meta{
+		Title:       "Mačva Press | Izveštaj za sponzora",
+		Description: "Izveštaj o sponzorisanom sadržaju",
+		Canonical:   BaseUrl + "/sponzorisano/" + contentIDStr,
+		OpenGraph: components.OpenGraphMeta{
+			Title:       "Mačva Press | Izveštaj za sponzora",
+			Description: "Izveštaj o sponzorisanom sadržaju",
+			URL:         BaseUrl + "/sponzorisano/" + contentIDStr,
+			Type:        "website",
+			Image:       BaseUrl + "/static/assets/macva-1-300x71.png",
+		},
+		Twitter: components.TwitterCardMeta{
+			Card:        "summary_large_image",
+			Title:       "Mačva Press | Izveštaj za sponzora",
+			Description: "Izveštaj o sponzorisanom sadržaju",
+			Image:       BaseUrl + "/static/assets/macva-1-300x71.png",
+			Creator:     "@MacvaNews",
+		},
+	}
+
+	return Render(ctx, http.StatusOK, components.SponsorReportPage(userData, meta, categories, report))
+}
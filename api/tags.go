@@ -130,6 +130,8 @@ func (server *Server) addTagToContent(ctx echo.Context) error {
 	}
 
 	// Success case - get tags and render
+	server.purgePages(ctx.Request().Context(), pageContentTag(contentID), pageTagTag(tagID))
+
 	tags, err := server.store.ListTags(ctx.Request().Context(), 1000)
 	if err != nil {
 		log.Println("Error listing tags in addTagToContent:", err)
@@ -190,6 +192,8 @@ func (server *Server) addTagToContentUpdate(ctx echo.Context) error {
 	}
 
 	// Success case - get tags and render
+	server.purgePages(ctx.Request().Context(), pageContentTag(contentID), pageTagTag(tagID))
+
 	tags, err := server.store.ListTags(ctx.Request().Context(), 1000)
 	if err != nil {
 		log.Println("Error listing tags in addTagToContentUpdate:", err)
@@ -245,6 +249,8 @@ func (server *Server) removeTagFromContent(ctx echo.Context) error {
 	}
 
 	// Success case - get tags and render
+	server.purgePages(ctx.Request().Context(), pageContentTag(contentID), pageTagTag(tagID))
+
 	tags, err := server.store.ListTags(ctx.Request().Context(), 1000)
 	if err != nil {
 		log.Println("Error listing tags in removeTagFromContent:", err)
@@ -291,6 +297,8 @@ func (server *Server) removeTagFromContentUpdate(ctx echo.Context) error {
 	}
 
 	// Success case - get tags and render
+	server.purgePages(ctx.Request().Context(), pageContentTag(contentID), pageTagTag(tagID))
+
 	tags, err := server.store.ListTags(ctx.Request().Context(), 1000)
 	if err != nil {
 		log.Println("Failed to get tags in removeTagFromContent:", err)
@@ -322,6 +330,8 @@ func (server *Server) deleteTag(ctx echo.Context) error {
 		return err
 	}
 
+	server.purgePages(ctx.Request().Context(), pageTagTag(tagID))
+
 	return ctx.NoContent(http.StatusOK)
 }
 
@@ -454,20 +464,21 @@ func (server *Server) listContentByTagsUnderCategory(ctx echo.Context) error {
 		}
 	}
 
-	// Get global settings for display options
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	// Get the engagement policy for display options, the articles' own flags
+	// are shown if it can't be fetched
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error fetching global settings:", err)
-		// Use default settings if we can't fetch them
-		globalSettings[0] = db.GlobalSetting{
-			DisableComments: false,
-			DisableLikes:    false,
-			DisableViews:    false,
+		log.Println("Error fetching engagement policy:", err)
+	}
+	for _, tagContent := range contentByTags {
+		for i := range tagContent.Content {
+			v := &tagContent.Content[i]
+			policy.apply(v.ContentID, v.CategoryID, v.PublishedAt, &v.CommentsEnabled, &v.LikeCountEnabled, &v.DislikeCountEnabled, &v.ViewCountEnabled)
 		}
 	}
 
 	// Render the templ component
-	return Render(ctx, http.StatusOK, components.ContentByTagsSection(contentByTags, globalSettings[0], category.CategoryName))
+	return Render(ctx, http.StatusOK, components.ContentByTagsSection(contentByTags, policy.global, category.CategoryName))
 }
 
 func (server *Server) listAllContentByTag(ctx echo.Context) error {
@@ -515,18 +526,16 @@ func (server *Server) GenerateRecentTagContentComponent(ctx echo.Context) (templ
 		}
 	}
 
-	globalSettings, err := server.store.GetGlobalSettings(ctx.Request().Context())
+	// The articles' own flags are shown if the policy can't be fetched
+	policy, err := server.engagementPolicy(ctx.Request().Context())
 	if err != nil {
-		log.Println("Error fetching global settings in listAllContentByTag:", err)
-		// Use default settings if we can't fetch them
-		globalSettings[0] = db.GlobalSetting{
-			DisableComments: false,
-			DisableLikes:    false,
-			DisableDislikes: true,
-			DisableViews:    false,
-		}
+		log.Println("Error fetching engagement policy in listAllContentByTag:", err)
+	}
+	for i := range content {
+		v := &content[i]
+		policy.apply(v.ContentID, v.CategoryID, v.PublishedAt, &v.CommentsEnabled, &v.LikeCountEnabled, &v.DislikeCountEnabled, &v.ViewCountEnabled)
 	}
 
-	return components.TagsGrid(tag.TagID.String(), content, int(nextLimit), globalSettings[0]), nil
+	return components.TagsGrid(tag.TagID.String(), content, int(nextLimit), policy.global), nil
 
 }
@@ -83,13 +83,17 @@ func (server *Server) login(ctx echo.Context) error {
 		return Render(ctx, http.StatusOK, components.LoginForm(loginErr))
 	}
 
-	if user.Banned.Bool {
-		loginErr = "Nevažecí podaci za prijavu"
-
-		return Render(ctx, http.StatusOK, components.LoginForm(loginErr))
-	}
+	// Only admins log in while the site is read-only or under maintenance, and
+	// nobody else is sent mail or a token before the check
+	closed := utils.SiteModeFromContext(ctx.Request().Context()).Mode != utils.SiteModeNormal && user.Role != "admin"
 
 	if !user.EmailVerified.Bool {
+		if closed {
+			loginErr = "Prijave su privremeno isključene. Pokušajte ponovo kasnije."
+
+			return Render(ctx, http.StatusOK, components.LoginForm(loginErr))
+		}
+
 		loginErr = "Email nije verifikovan. Poslat je nov link za verifikaciju na vašu adresu."
 
 		token, err := utils.GenerateToken(jwt.MapClaims{
@@ -119,6 +123,25 @@ func (server *Server) login(ctx echo.Context) error {
 		return Render(ctx, http.StatusOK, components.LoginForm(loginErr))
 	}
 
+	// Bans and suspensions are explained only once the password is right
+	sanctions, err := server.getUserSanctions(ctx.Request().Context(), user.UserID)
+	if err != nil {
+		log.Println("Error getting user sanctions in login:", err)
+		return err
+	}
+
+	if message := sanctions.lockedMessage(user.Banned.Bool); message != "" {
+		loginErr = components.LoginErr(message)
+
+		return Render(ctx, http.StatusOK, components.LoginForm(loginErr))
+	}
+
+	if closed {
+		loginErr = "Prijave su privremeno isključene. Pokušajte ponovo kasnije."
+
+		return Render(ctx, http.StatusOK, components.LoginForm(loginErr))
+	}
+
 	durationStr := os.Getenv("ACCESS_TOKEN_DURATION")
 	duration, err := time.ParseDuration(durationStr)
 	if err != nil {
@@ -222,6 +245,12 @@ func (server *Server) login(ctx echo.Context) error {
 		Secure:   true,
 	})
 
+	// Advertisers come to read their reports
+	if user.Role == roleAdvertiser {
+		ctx.Response().Header().Set("HX-Redirect", "/oglasivac")
+		return ctx.NoContent(http.StatusOK)
+	}
+
 	ctx.Response().Header().Set("HX-Redirect", "/")
 	return ctx.NoContent(http.StatusOK)
 }
@@ -760,6 +789,18 @@ func (server *Server) banUser(ctx echo.Context) error {
 		return err
 	}
 
+	// The reason moderators type into the prompt is shown to the user at login
+	_, err = server.store.CreateUserSanction(ctx.Request().Context(), db.CreateUserSanctionParams{
+		UserID:    userID,
+		Kind:      sanctionBan,
+		Reason:    strings.TrimSpace(ctx.Request().Header.Get("HX-Prompt")),
+		CreatedBy: moderatorID(ctx),
+	})
+	if err != nil {
+		log.Println("Error recording ban in banUser:", err)
+		return err
+	}
+
 	activeCount, err := server.store.GetActiveUsersCount(ctx.Request().Context())
 	if err != nil {
 		log.Println("Error getting active users count in adminUsers:", err)
@@ -784,14 +825,10 @@ func (server *Server) banUser(ctx echo.Context) error {
 		DeletedUsersCount: int(delCount),
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "user*")
-	if err != nil {
-		log.Println("Error deleting cache in banUser:", err)
-		return err
-	}
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
+	// Drops the user and every cached comment list showing their name or picture
+	err = server.cacheService.InvalidateTags(ctx.Request().Context(), userCacheTag(userID))
 	if err != nil {
-		log.Println("Error deleting cache in banUser:", err)
+		log.Println("Error invalidating cache in banUser:", err)
 		return err
 	}
 
@@ -812,6 +849,15 @@ func (server *Server) unbanUser(ctx echo.Context) error {
 		return err
 	}
 
+	err = server.store.LiftUserSanctionsByKind(ctx.Request().Context(), db.LiftUserSanctionsByKindParams{
+		UserID: userID,
+		Kind:   sanctionBan,
+	})
+	if err != nil {
+		log.Println("Error lifting ban in unbanUser:", err)
+		return err
+	}
+
 	activeCount, err := server.store.GetActiveUsersCount(ctx.Request().Context())
 	if err != nil {
 		log.Println("Error getting active users count in adminUsers:", err)
@@ -836,14 +882,9 @@ func (server *Server) unbanUser(ctx echo.Context) error {
 		DeletedUsersCount: int(delCount),
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "user*")
+	err = server.cacheService.InvalidateTags(ctx.Request().Context(), userCacheTag(userID))
 	if err != nil {
-		log.Println("Error deleting cache in unbanUser:", err)
-		return err
-	}
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
-	if err != nil {
-		log.Println("Error deleting cache in banUser:", err)
+		log.Println("Error invalidating cache in unbanUser:", err)
 		return err
 	}
 
@@ -888,14 +929,9 @@ func (server *Server) deleteUser(ctx echo.Context) error {
 		DeletedUsersCount: int(delCount),
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "user*")
-	if err != nil {
-		log.Println("Error deleting user from cache in deleteUser:", err)
-		return err
-	}
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
+	err = server.cacheService.InvalidateTags(ctx.Request().Context(), userCacheTag(userID))
 	if err != nil {
-		log.Println("Error deleting cache in banUser:", err)
+		log.Println("Error invalidating cache in deleteUser:", err)
 		return err
 	}
 
@@ -946,14 +982,9 @@ func (server *Server) updateUsername(ctx echo.Context) error {
 		return err
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "user*")
+	err = server.cacheService.InvalidateTags(ctx.Request().Context(), userCacheTag(userID))
 	if err != nil {
-		log.Println("Error deleting cache in updateUsername:", err)
-		return err
-	}
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
-	if err != nil {
-		log.Println("Error deleting cache in banUser:", err)
+		log.Println("Error invalidating cache in updateUsername:", err)
 		return err
 	}
 
@@ -1062,15 +1093,9 @@ func (server *Server) updatePfp(ctx echo.Context) error {
 		return err
 	}
 
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "user*")
-	if err != nil {
-		log.Println("Error deleting cache in updatePfp:", err)
-		// If cache deletion fails, delete the newly uploaded file to avoid orphaned files
-		return err
-	}
-	err = server.cacheService.DeleteByPattern(ctx.Request().Context(), "comments*")
+	err = server.cacheService.InvalidateTags(ctx.Request().Context(), userCacheTag(userID))
 	if err != nil {
-		log.Println("Error deleting cache in banUser:", err)
+		log.Println("Error invalidating cache in updatePfp:", err)
 		return err
 	}
 
@@ -25,7 +25,13 @@ func (server *Server) getUserFromCacheOrDb(ctx echo.Context, cookieName string)
 		return userData, err
 	}
 
-	cacheKey := redis.GenerateKey("user", payload.UserID)
+	userID, err := utils.ParseUUID(payload.UserID, "userID")
+	if err != nil {
+		log.Println("Error parsing user_id in getUserFromCookieOrCache:", err)
+		return userData, err
+	}
+
+	cacheKey := redis.GenerateKey("user", userID)
 
 	cacheHit, err := server.cacheService.Get(ctx.Request().Context(), cacheKey, &userData)
 	if err != nil {
@@ -36,18 +42,12 @@ func (server *Server) getUserFromCacheOrDb(ctx echo.Context, cookieName string)
 	}
 
 	log.Printf("Cache miss for user: %s", cacheKey)
-	userID, err := utils.ParseUUID(payload.UserID, "userID")
-	if err != nil {
-		log.Println("Error parsing user_id in getUserFromCookieOrCache:", err)
-		return userData, err
-	}
-
 	userData, err = server.store.GetUserByID(ctx.Request().Context(), userID)
 	if err != nil {
 		return userData, err
 	}
 
-	if err := server.cacheService.Set(ctx.Request().Context(), cacheKey, &userData, 10*time.Minute); err != nil {
+	if err := server.cacheService.SetWithTags(ctx.Request().Context(), cacheKey, &userData, 10*time.Minute, userCacheTag(userID)); err != nil {
 		log.Printf("Error setting user in cache: %v", err)
 	}
 
@@ -23,6 +23,7 @@ func NewCustomValidator() *CustomValidator {
 	v.RegisterValidation("regex", validCategoryName)
 	v.RegisterValidation("password", validPassword)
 	v.RegisterValidation("username", validUsername)
+	v.RegisterValidation("slotkey", validSlotKey)
 
 	return &CustomValidator{validator: v}
 }
@@ -107,3 +108,15 @@ var validUsername validator.Func = func(fl validator.FieldLevel) bool {
 	// Username is valid if it passes all checks
 	return true
 }
+
+// validSlotKey enforces the "slotkey" tag, ad placement keys are used in
+// element IDs so they're kept to lowercase letters, digits and dashes.
+var validSlotKey validator.Func = func(fl validator.FieldLevel) bool {
+	key := fl.Field().String()
+	for _, r := range key {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
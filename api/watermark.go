@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/00mark0/macva-press/components"
+	"github.com/00mark0/macva-press/db/services"
+	"github.com/00mark0/macva-press/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// watermarkFromSettings returns the configured watermark, or nil if it is turned off.
+func watermarkFromSettings(settings db.GlobalSetting) *utils.Watermark {
+	if !settings.WatermarkEnabled {
+		return nil
+	}
+
+	return &utils.Watermark{
+		Logo:     settings.WatermarkLogo,
+		Position: settings.WatermarkPosition,
+		Opacity:  float64(settings.WatermarkOpacity),
+		Scale:    float64(settings.WatermarkScale),
+	}
+}
+
+// uploadWatermark returns the watermark for a new upload, nil when the editor
+// opted out (e.g. agency photos) or watermarking is turned off.
+func (server *Server) uploadWatermark(ctx context.Context, watermark bool) *utils.Watermark {
+	if !watermark {
+		return nil
+	}
+
+	globalSettings, err := server.store.GetGlobalSettings(ctx)
+	if err != nil || len(globalSettings) == 0 {
+		log.Println("Error getting global settings in uploadWatermark:", err)
+		return nil
+	}
+
+	return watermarkFromSettings(globalSettings[0])
+}
+
+type WatermarkSettingsReq struct {
+	WatermarkEnabled  bool    `form:"watermark_enabled"`
+	WatermarkLogo     string  `form:"watermark_logo" validate:"required"`
+	WatermarkPosition string  `form:"watermark_position" validate:"required"`
+	WatermarkOpacity  float32 `form:"watermark_opacity" validate:"gte=0.05,lte=1"`
+	WatermarkScale    float32 `form:"watermark_scale" validate:"gte=0.05,lte=0.5"`
+}
+
+func (server *Server) updateWatermarkSettings(ctx echo.Context) error {
+	var req WatermarkSettingsReq
+
+	if err := ctx.Bind(&req); err != nil {
+		log.Println("Error binding request in updateWatermarkSettings:", err)
+		return err
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		message := "Providnost mora biti između 5% i 100%, a veličina između 5% i 50% širine fotografije."
+
+		return Render(ctx, http.StatusOK, components.UpdateError(message))
+	}
+
+	if !slices.Contains(utils.WatermarkPositions, req.WatermarkPosition) {
+		return Render(ctx, http.StatusOK, components.UpdateError("Nepoznata pozicija vodenog žiga."))
+	}
+
+	if !slices.Contains(utils.WatermarkLogos(), req.WatermarkLogo) {
+		return Render(ctx, http.StatusOK, components.UpdateError("Izabrani logo ne postoji."))
+	}
+
+	err := server.store.UpdateWatermarkSettings(ctx.Request().Context(), db.UpdateWatermarkSettingsParams{
+		WatermarkEnabled:  req.WatermarkEnabled,
+		WatermarkLogo:     req.WatermarkLogo,
+		WatermarkPosition: req.WatermarkPosition,
+		WatermarkOpacity:  req.WatermarkOpacity,
+		WatermarkScale:    req.WatermarkScale,
+	})
+	if err != nil {
+		log.Println("Error updating watermark settings in updateWatermarkSettings:", err)
+		return err
+	}
+
+	return Render(ctx, http.StatusOK, components.UpdateSuccess("Podešavanja vodenog žiga su sačuvana. Važe za nove fotografije."))
+}
+
+// regenerateWatermarks rebuilds every photo that has a kept original with the
+// current watermark settings. It runs in the background, one run at a time.
+func (server *Server) regenerateWatermarks(ctx echo.Context) error {
+	select {
+	case server.watermarkJob <- struct{}{}:
+	default:
+		return Render(ctx, http.StatusOK, components.UpdateError("Fotografije se već ponovo generišu."))
+	}
+
+	go func() {
+		defer func() { <-server.watermarkJob }()
+		server.rebuildMediaFromOriginals(context.Background())
+	}()
+
+	return Render(ctx, http.StatusOK, components.UpdateSuccess("Fotografije se ponovo generišu u pozadini."))
+}
+
+func (server *Server) rebuildMediaFromOriginals(ctx context.Context) {
+	globalSettings, err := server.store.GetGlobalSettings(ctx)
+	if err != nil || len(globalSettings) == 0 {
+		log.Println("Error getting global settings in rebuildMediaFromOriginals:", err)
+		return
+	}
+	wm := watermarkFromSettings(globalSettings[0])
+
+	media, err := server.store.ListMediaWithOriginals(ctx)
+	if err != nil {
+		log.Println("Error listing media with originals in rebuildMediaFromOriginals:", err)
+		return
+	}
+
+	// A reused file is listed once per media item using it
+	seen := make(map[string]bool)
+	rebuilt := 0
+	for _, m := range media {
+		if seen[m.MediaUrl] {
+			continue
+		}
+		seen[m.MediaUrl] = true
+
+		var watermark *utils.Watermark
+		if m.Watermark {
+			watermark = wm
+		}
+
+		// Same size and quality as the upload pipeline. Resized variants are
+		// keyed by modification time, so /img picks up the new file by itself.
+		err := encodeWebP(m.OriginalPath, strings.TrimPrefix(m.MediaUrl, "/"), 800, 600, 80, watermark)
+		if err != nil {
+			log.Println("Error rebuilding media in rebuildMediaFromOriginals:", err)
+			continue
+		}
+		rebuilt++
+	}
+
+	log.Printf("Rebuilt %d of %d photos from originals", rebuilt, len(seen))
+}
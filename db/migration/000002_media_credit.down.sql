@@ -0,0 +1 @@
+ALTER TABLE "media" DROP COLUMN IF EXISTS "media_credit";
@@ -0,0 +1 @@
+ALTER TABLE "media" ADD COLUMN "media_credit" TEXT NOT NULL DEFAULT '';
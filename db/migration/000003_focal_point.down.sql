@@ -0,0 +1,5 @@
+ALTER TABLE "content" DROP COLUMN IF EXISTS "thumbnail_focal_y";
+ALTER TABLE "content" DROP COLUMN IF EXISTS "thumbnail_focal_x";
+
+ALTER TABLE "media" DROP COLUMN IF EXISTS "focal_y";
+ALTER TABLE "media" DROP COLUMN IF EXISTS "focal_x";
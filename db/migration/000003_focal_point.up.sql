@@ -0,0 +1,5 @@
+ALTER TABLE "media" ADD COLUMN "focal_x" REAL NOT NULL DEFAULT 0.5;
+ALTER TABLE "media" ADD COLUMN "focal_y" REAL NOT NULL DEFAULT 0.5;
+
+ALTER TABLE "content" ADD COLUMN "thumbnail_focal_x" REAL NOT NULL DEFAULT 0.5;
+ALTER TABLE "content" ADD COLUMN "thumbnail_focal_y" REAL NOT NULL DEFAULT 0.5;
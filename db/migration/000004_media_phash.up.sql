@@ -0,0 +1,3 @@
+ALTER TABLE "media" ADD COLUMN "phash" BIGINT;
+
+CREATE INDEX "idx_media_url" ON "media"("media_url");
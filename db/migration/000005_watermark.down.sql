@@ -0,0 +1,8 @@
+ALTER TABLE "media" DROP COLUMN IF EXISTS "watermark";
+ALTER TABLE "media" DROP COLUMN IF EXISTS "original_path";
+
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "watermark_scale";
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "watermark_opacity";
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "watermark_position";
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "watermark_logo";
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "watermark_enabled";
@@ -0,0 +1,8 @@
+ALTER TABLE "global_settings" ADD COLUMN "watermark_enabled" BOOL NOT NULL DEFAULT false;
+ALTER TABLE "global_settings" ADD COLUMN "watermark_logo" TEXT NOT NULL DEFAULT '/static/assets/macva-1-300x71.png';
+ALTER TABLE "global_settings" ADD COLUMN "watermark_position" VARCHAR(20) NOT NULL DEFAULT 'bottom-right';
+ALTER TABLE "global_settings" ADD COLUMN "watermark_opacity" REAL NOT NULL DEFAULT 0.5;
+ALTER TABLE "global_settings" ADD COLUMN "watermark_scale" REAL NOT NULL DEFAULT 0.2;
+
+ALTER TABLE "media" ADD COLUMN "original_path" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "media" ADD COLUMN "watermark" BOOL NOT NULL DEFAULT false;
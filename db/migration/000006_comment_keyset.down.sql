@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "idx_comment_parent_created_id";
+DROP INDEX IF EXISTS "idx_comment_content_score_id";
+DROP INDEX IF EXISTS "idx_comment_content_created_id";
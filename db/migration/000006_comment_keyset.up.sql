@@ -0,0 +1,4 @@
+-- Keyset pagination walks comments in (created_at, comment_id) and (score, comment_id) order
+CREATE INDEX "idx_comment_content_created_id" ON comment("content_id", "created_at" DESC, "comment_id" DESC) WHERE "parent_comment_id" IS NULL AND "is_deleted" = false;
+CREATE INDEX "idx_comment_content_score_id" ON comment("content_id", "score" DESC, "comment_id" DESC) WHERE "parent_comment_id" IS NULL AND "is_deleted" = false;
+CREATE INDEX "idx_comment_parent_created_id" ON comment("parent_comment_id", "created_at", "comment_id") WHERE "is_deleted" = false;
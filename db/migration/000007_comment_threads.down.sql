@@ -0,0 +1 @@
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "comment_max_depth";
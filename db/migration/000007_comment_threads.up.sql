@@ -0,0 +1,2 @@
+-- How deep replies to replies nest, deeper replies go next to the comment they answer
+ALTER TABLE "global_settings" ADD COLUMN "comment_max_depth" INT NOT NULL DEFAULT 4;
@@ -0,0 +1,19 @@
+CREATE TABLE "notification" (
+  "notification_id" UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  "user_id" UUID NOT NULL REFERENCES "user" ("user_id") ON DELETE CASCADE,
+  "actor_id" UUID NOT NULL REFERENCES "user" ("user_id") ON DELETE CASCADE,
+  "comment_id" UUID NOT NULL REFERENCES "comment" ("comment_id") ON DELETE CASCADE,
+  "kind" VARCHAR(20) NOT NULL,
+  "is_read" BOOL NOT NULL DEFAULT false,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- A comment notifies each reader once, as a reply or as a mention
+CREATE UNIQUE INDEX "idx_notification_user_comment" ON "notification" ("user_id", "comment_id");
+CREATE INDEX "idx_notification_user_unread" ON "notification" ("user_id", "is_read", "created_at");
+
+CREATE TABLE "notification_settings" (
+  "user_id" UUID PRIMARY KEY REFERENCES "user" ("user_id") ON DELETE CASCADE,
+  "email_replies" BOOL NOT NULL DEFAULT true,
+  "email_mentions" BOOL NOT NULL DEFAULT true
+);
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS "spam_training";
+DROP TABLE IF EXISTS "spam_token";
+
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "comment_blocklist";
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "comment_max_links";
+
+DROP INDEX IF EXISTS "idx_comment_text_hash";
+DROP INDEX IF EXISTS "idx_comment_held";
+ALTER TABLE "comment" DROP COLUMN IF EXISTS "held_reason";
+ALTER TABLE "comment" DROP COLUMN IF EXISTS "is_held";
@@ -0,0 +1,25 @@
+-- Comments the spam filters hold stay hidden until a moderator approves them
+ALTER TABLE "comment" ADD COLUMN "is_held" BOOL NOT NULL DEFAULT false;
+ALTER TABLE "comment" ADD COLUMN "held_reason" TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX "idx_comment_held" ON "comment" ("created_at") WHERE "is_held" = true;
+CREATE INDEX "idx_comment_text_hash" ON "comment" (md5(lower("comment_text")), "created_at");
+
+ALTER TABLE "global_settings" ADD COLUMN "comment_max_links" INT NOT NULL DEFAULT 2;
+-- One word or phrase per line, in Cyrillic or Latin
+ALTER TABLE "global_settings" ADD COLUMN "comment_blocklist" TEXT NOT NULL DEFAULT '';
+
+-- Naive Bayes classifier, trained on moderator decisions
+CREATE TABLE "spam_token" (
+  "token" TEXT PRIMARY KEY,
+  "spam_count" INT NOT NULL DEFAULT 0,
+  "ham_count" INT NOT NULL DEFAULT 0
+);
+
+-- The comments the classifier learned from, so none is counted twice. Rejected
+-- comments are deleted, so there is no foreign key.
+CREATE TABLE "spam_training" (
+  "comment_id" UUID PRIMARY KEY,
+  "is_spam" BOOL NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
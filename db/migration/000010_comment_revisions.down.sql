@@ -0,0 +1,3 @@
+ALTER TABLE "global_settings" DROP COLUMN IF EXISTS "comment_edit_window";
+
+DROP TABLE IF EXISTS "comment_revision";
@@ -0,0 +1,12 @@
+-- Earlier versions of edited comments, the current text stays in comment
+CREATE TABLE "comment_revision" (
+  "revision_id" UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  "comment_id" UUID NOT NULL REFERENCES "comment" ("comment_id") ON DELETE CASCADE,
+  "comment_text" TEXT NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX "idx_comment_revision_comment" ON "comment_revision" ("comment_id", "created_at");
+
+-- How many minutes after posting readers may edit a comment, 0 turns editing off
+ALTER TABLE "global_settings" ADD COLUMN "comment_edit_window" INT NOT NULL DEFAULT 15;
@@ -0,0 +1,3 @@
+ALTER TABLE "comment" DROP COLUMN IF EXISTS "is_shadowed";
+
+DROP TABLE IF EXISTS "user_sanction";
@@ -0,0 +1,17 @@
+-- Bans, suspensions, mutes and shadow-bans with the reason shown to the user.
+-- A sanction without expires_at lasts until a moderator lifts it.
+CREATE TABLE "user_sanction" (
+  "sanction_id" UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  "user_id" UUID NOT NULL REFERENCES "user" ("user_id") ON DELETE CASCADE,
+  "kind" VARCHAR(20) NOT NULL CHECK ("kind" IN ('ban', 'suspension', 'mute', 'shadowban')),
+  "reason" TEXT NOT NULL DEFAULT '',
+  "expires_at" TIMESTAMPTZ,
+  "created_by" UUID REFERENCES "user" ("user_id") ON DELETE SET NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+  "lifted_at" TIMESTAMPTZ
+);
+
+CREATE INDEX "idx_user_sanction_user" ON "user_sanction" ("user_id", "created_at");
+
+-- Comments of shadow-banned users are visible only to their author
+ALTER TABLE "comment" ADD COLUMN "is_shadowed" BOOL NOT NULL DEFAULT false;
@@ -0,0 +1,12 @@
+-- Impressions and clicks of each ad per placement and day, counted once per
+-- visitor and without known bots.
+CREATE TABLE "ad_stat" (
+  "ad_id" UUID NOT NULL REFERENCES "ads" ("id") ON DELETE CASCADE,
+  "placement" VARCHAR(50) NOT NULL,
+  "stat_date" DATE NOT NULL,
+  "impressions" INT NOT NULL DEFAULT 0,
+  "clicks" INT NOT NULL DEFAULT 0,
+  PRIMARY KEY ("ad_id", "placement", "stat_date")
+);
+
+CREATE INDEX "idx_ad_stat_date" ON "ad_stat" ("stat_date");
@@ -0,0 +1,5 @@
+ALTER TABLE "ads"
+  DROP COLUMN IF EXISTS "is_house",
+  DROP COLUMN IF EXISTS "impression_goal",
+  DROP COLUMN IF EXISTS "frequency_cap",
+  DROP COLUMN IF EXISTS "weight";
@@ -0,0 +1,9 @@
+-- Several campaigns can share a placement, each request picks one by weight.
+-- A frequency cap limits how often a visitor is served an ad per day and a
+-- campaign stops once it reaches its impression goal (0 means no limit).
+-- House ads are served only when no booked campaign is left for a placement.
+ALTER TABLE "ads"
+  ADD COLUMN "weight" INT NOT NULL DEFAULT 1 CHECK ("weight" BETWEEN 1 AND 100),
+  ADD COLUMN "frequency_cap" INT NOT NULL DEFAULT 0 CHECK ("frequency_cap" >= 0),
+  ADD COLUMN "impression_goal" INT NOT NULL DEFAULT 0 CHECK ("impression_goal" >= 0),
+  ADD COLUMN "is_house" BOOL NOT NULL DEFAULT false;
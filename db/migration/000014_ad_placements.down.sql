@@ -0,0 +1,3 @@
+ALTER TABLE "ads" DROP CONSTRAINT IF EXISTS "ads_placement_fkey";
+
+DROP TABLE IF EXISTS "ad_placement";
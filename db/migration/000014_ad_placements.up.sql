@@ -0,0 +1,39 @@
+-- The ad slots pages have. Each slot takes creatives of its size in the
+-- allowed formats and is filled only on the listed page types, so slots can
+-- be changed without a deploy.
+CREATE TABLE "ad_placement" (
+  "key" VARCHAR(50) PRIMARY KEY,
+  "name" VARCHAR(100) NOT NULL,
+  "width" INT NOT NULL CHECK ("width" > 0),
+  "height" INT NOT NULL CHECK ("height" > 0),
+  "formats" TEXT[] NOT NULL DEFAULT '{image}',
+  "page_types" TEXT[] NOT NULL DEFAULT '{}',
+  "is_active" BOOL NOT NULL DEFAULT true,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO "ad_placement" ("key", "name", "width", "height", "formats", "page_types") VALUES
+  ('header', 'Header', 1200, 300, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('sidebar-1', 'Sidebar 1', 300, 250, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('sidebar-2', 'Sidebar 2', 300, 250, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('sidebar-3', 'Sidebar 3', 300, 250, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('sidebar-4', 'Sidebar 4', 300, 250, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('sidebar-5', 'Sidebar 5', 300, 250, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('sidebar-6', 'Sidebar 6', 300, 250, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('sidebar-7', 'Sidebar 7', 300, 250, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('sidebar-8', 'Sidebar 8', 300, 250, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('footer', 'Footer', 1200, 300, '{image,video}', '{home,category,tag,article,search,other}'),
+  ('article', 'Članak', 1200, 300, '{image,video}', '{article}');
+
+-- Ads in placements that were never offered are kept, their slot is added
+-- inactive so an admin can size it or move the ads.
+INSERT INTO "ad_placement" ("key", "name", "width", "height", "is_active")
+SELECT DISTINCT "placement", "placement", 300, 250, false
+FROM "ads"
+WHERE "placement" IS NOT NULL
+ON CONFLICT ("key") DO NOTHING;
+
+ALTER TABLE "ads"
+  ADD CONSTRAINT "ads_placement_fkey" FOREIGN KEY ("placement")
+  REFERENCES "ad_placement" ("key") ON UPDATE CASCADE;
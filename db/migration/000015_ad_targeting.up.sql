@@ -0,0 +1,14 @@
+-- Where and when an ad may be served. Empty include lists match everything,
+-- exclude lists win over include lists. Ads are served from daypart_start
+-- to daypart_end (Belgrade time), a start after the end wraps past midnight.
+CREATE TABLE "ad_targeting" (
+  "ad_id" UUID PRIMARY KEY REFERENCES "ads" ("id") ON DELETE CASCADE,
+  "target_categories" UUID[] NOT NULL DEFAULT '{}',
+  "exclude_categories" UUID[] NOT NULL DEFAULT '{}',
+  "target_tags" UUID[] NOT NULL DEFAULT '{}',
+  "exclude_tags" UUID[] NOT NULL DEFAULT '{}',
+  "target_page_types" TEXT[] NOT NULL DEFAULT '{}',
+  "exclude_page_types" TEXT[] NOT NULL DEFAULT '{}',
+  "daypart_start" INT NOT NULL DEFAULT 0 CHECK ("daypart_start" BETWEEN 0 AND 23),
+  "daypart_end" INT NOT NULL DEFAULT 24 CHECK ("daypart_end" BETWEEN 1 AND 24)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "ad_advertiser";
+
+UPDATE "user" SET "role" = 'user' WHERE "role" = 'advertiser';
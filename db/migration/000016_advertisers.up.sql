@@ -0,0 +1,10 @@
+-- Advertisers are users with the 'advertiser' role, each linked to the ads
+-- they booked. They see the reports of those ads and nothing else.
+CREATE TABLE "ad_advertiser" (
+  "ad_id" UUID NOT NULL REFERENCES "ads" ("id") ON DELETE CASCADE,
+  "user_id" UUID NOT NULL REFERENCES "user" ("user_id") ON DELETE CASCADE,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("ad_id", "user_id")
+);
+
+CREATE INDEX "idx_ad_advertiser_user" ON "ad_advertiser" ("user_id");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "idx_ads_state";
+
+ALTER TABLE "ads"
+  DROP COLUMN IF EXISTS "state_changed_at",
+  DROP COLUMN IF EXISTS "state";
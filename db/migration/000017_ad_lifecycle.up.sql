@@ -0,0 +1,26 @@
+-- Where an ad is in its flight: scheduled -> active -> ending_soon -> expired
+-- -> archived. Only the TransitionAds query moves ads between states, from
+-- their dates. The status column stays the admin's on/off switch, expired
+-- ads are switched off.
+ALTER TABLE "ads"
+  ADD COLUMN "state" VARCHAR(20) NOT NULL DEFAULT 'scheduled'
+    CHECK ("state" IN ('scheduled', 'active', 'ending_soon', 'expired', 'archived')),
+  ADD COLUMN "state_changed_at" TIMESTAMPTZ NOT NULL DEFAULT (now());
+
+-- Ads deactivated before had their dates cleared, they expired when they
+-- were last updated and TransitionAds archives them from there
+UPDATE "ads"
+SET "state" = CASE
+  WHEN "start_date" IS NULL OR "end_date" IS NULL THEN 'expired'
+  WHEN "end_date" <= now() - INTERVAL '30 days' THEN 'archived'
+  WHEN "end_date" <= now() THEN 'expired'
+  WHEN "start_date" > now() THEN 'scheduled'
+  WHEN "end_date" <= now() + INTERVAL '7 days' THEN 'ending_soon'
+  ELSE 'active'
+END,
+"state_changed_at" = CASE
+  WHEN "start_date" IS NULL OR "end_date" IS NULL THEN COALESCE("updated_at", now())
+  ELSE now()
+END;
+
+CREATE INDEX "idx_ads_state" ON "ads" ("state");
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "sponsored_stat";
+
+ALTER TABLE "content"
+  DROP CONSTRAINT IF EXISTS "content_sponsor_name_check",
+  DROP COLUMN IF EXISTS "sponsor_logo",
+  DROP COLUMN IF EXISTS "sponsor_name",
+  DROP COLUMN IF EXISTS "is_sponsored";
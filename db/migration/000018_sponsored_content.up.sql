@@ -0,0 +1,17 @@
+-- Paid articles. They are labelled as sponsored wherever they are listed,
+-- their outbound links carry rel="sponsored" and they are left out of
+-- trending and related lists.
+ALTER TABLE "content"
+  ADD COLUMN "is_sponsored" BOOL NOT NULL DEFAULT false,
+  ADD COLUMN "sponsor_name" VARCHAR(100),
+  ADD COLUMN "sponsor_logo" TEXT,
+  ADD CONSTRAINT "content_sponsor_name_check" CHECK (NOT "is_sponsored" OR "sponsor_name" IS NOT NULL);
+
+-- Unique views of sponsored articles per day, for the reports shared with
+-- the sponsor
+CREATE TABLE "sponsored_stat" (
+  "content_id" UUID NOT NULL REFERENCES "content" ("content_id") ON DELETE CASCADE,
+  "stat_date" DATE NOT NULL,
+  "views" INT NOT NULL DEFAULT 0,
+  PRIMARY KEY ("content_id", "stat_date")
+);
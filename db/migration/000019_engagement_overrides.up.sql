@@ -0,0 +1,16 @@
+-- Engagement rules between the global settings and each article's own
+-- flags. A rule belongs to a category or to an article and switches one
+-- setting on or off, right away or once the article is after_hours old.
+CREATE TABLE "engagement_override" (
+  "id" UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  "category_id" UUID REFERENCES "category" ("category_id") ON DELETE CASCADE,
+  "content_id" UUID REFERENCES "content" ("content_id") ON DELETE CASCADE,
+  "setting" VARCHAR(20) NOT NULL CHECK ("setting" IN ('comments', 'likes', 'dislikes', 'views', 'ads')),
+  "enabled" BOOL NOT NULL,
+  "after_hours" INT CHECK ("after_hours" > 0),
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT (now()),
+  CONSTRAINT "engagement_override_scope_check" CHECK (("category_id" IS NULL) <> ("content_id" IS NULL))
+);
+
+CREATE UNIQUE INDEX "engagement_override_category_setting_idx" ON "engagement_override" ("category_id", "setting") WHERE "category_id" IS NOT NULL;
+CREATE UNIQUE INDEX "engagement_override_content_setting_idx" ON "engagement_override" ("content_id", "setting") WHERE "content_id" IS NOT NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE "global_settings"
+  DROP CONSTRAINT IF EXISTS "global_settings_site_mode_check",
+  DROP COLUMN IF EXISTS "site_mode_message",
+  DROP COLUMN IF EXISTS "site_mode";
@@ -0,0 +1,6 @@
+-- Read-only and maintenance modes switched from the admin settings. Every
+-- instance keeps the mode in memory and hears about changes through Redis.
+ALTER TABLE "global_settings"
+  ADD COLUMN "site_mode" VARCHAR(20) NOT NULL DEFAULT 'normal',
+  ADD COLUMN "site_mode_message" TEXT NOT NULL DEFAULT '',
+  ADD CONSTRAINT "global_settings_site_mode_check" CHECK ("site_mode" IN ('normal', 'read_only', 'maintenance'));
@@ -0,0 +1,6 @@
+-- Images the perceptual hash backfill couldn't decode (GIFs, corrupt or
+-- missing files), so it tries each of them only once.
+CREATE TABLE "media_hash_failure" (
+  "media_id" UUID PRIMARY KEY REFERENCES "media" ("media_id") ON DELETE CASCADE,
+  "failed_at" TIMESTAMPTZ NOT NULL DEFAULT (now())
+);
@@ -1,8 +1,8 @@
 -- name: CreateAd :one
 INSERT INTO "ads"
-("title", "description", "image_url", "target_url", "placement", "status", "start_date", "end_date")
+("title", "description", "image_url", "target_url", "placement", "status", "start_date", "end_date", "weight", "frequency_cap", "impression_goal", "is_house")
 VALUES
-($1, $2, $3, $4, $5, $6, $7, $8)
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
 
 -- name: UpdateAd :one
@@ -16,8 +16,12 @@ SET
   "status" = $6,
   "start_date" = $7,
   "end_date" = $8,
+  "weight" = $9,
+  "frequency_cap" = $10,
+  "impression_goal" = $11,
+  "is_house" = $12,
   "updated_at" = now()
-WHERE "id" = $9
+WHERE "id" = $13
 RETURNING *;
 
 -- name: DeactivateAd :one
@@ -30,6 +34,17 @@ SET
 WHERE "id" = $1
 RETURNING *;
 
+-- name: ExtendAd :one
+-- Moves a campaign's dates and switches it back on
+UPDATE "ads"
+SET
+  "start_date" = $2,
+  "end_date" = $3,
+  "status" = 'active',
+  "updated_at" = now()
+WHERE "id" = $1
+RETURNING *;
+
 -- name: DeleteAd :exec
 DELETE FROM "ads"
 WHERE "id" = $1;
@@ -48,9 +63,17 @@ LIMIT $1;
 SELECT *
 FROM "ads"
 WHERE "status" = 'inactive'
+  AND "state" <> 'archived'
 ORDER BY "created_at" DESC
 LIMIT $1;
 
+-- name: ListArchivedAds :many
+SELECT *
+FROM "ads"
+WHERE "state" = 'archived'
+ORDER BY "end_date" DESC
+LIMIT $1;
+
 -- name: ListScheduledAds :many
 SELECT *
 FROM "ads"
@@ -77,6 +100,55 @@ WHERE "placement" = $1
   AND "end_date" >= now() AT TIME ZONE 'Europe/Belgrade'
 LIMIT $2;
 
+-- name: ListServableAds :many
+-- Running campaigns and house ads with the impressions they have had so far
+-- and where they are targeted. Running is the active and ending_soon states
+-- of TransitionAds, by the same clock.
+SELECT a.*,
+  COALESCE((SELECT SUM(s.impressions) FROM "ad_stat" s WHERE s.ad_id = a.id), 0)::INT AS impressions,
+  COALESCE(t.target_categories, '{}')::UUID[] AS target_categories,
+  COALESCE(t.exclude_categories, '{}')::UUID[] AS exclude_categories,
+  COALESCE(t.target_tags, '{}')::UUID[] AS target_tags,
+  COALESCE(t.exclude_tags, '{}')::UUID[] AS exclude_tags,
+  COALESCE(t.target_page_types, '{}')::TEXT[] AS target_page_types,
+  COALESCE(t.exclude_page_types, '{}')::TEXT[] AS exclude_page_types,
+  COALESCE(t.daypart_start, 0)::INT AS daypart_start,
+  COALESCE(t.daypart_end, 24)::INT AS daypart_end
+FROM "ads" a
+LEFT JOIN "ad_targeting" t ON t.ad_id = a.id
+WHERE a.status = 'active'
+  AND a.start_date <= now()
+  AND a.end_date > now()
+ORDER BY a.created_at DESC;
+
+-- name: TransitionAds :many
+-- Moves every ad to the state its dates put it in, switching expired ones
+-- off, and returns the ads that changed state. Ads switched off by hand have
+-- no dates, they are archived archive_after_days after they expired.
+UPDATE "ads" a
+SET
+  "state" = next.state,
+  "status" = CASE WHEN next.state IN ('expired', 'archived') THEN 'inactive' ELSE a.status END,
+  "state_changed_at" = now(),
+  "updated_at" = now()
+FROM (
+  SELECT "id",
+    CASE
+      WHEN ("start_date" IS NULL OR "end_date" IS NULL)
+        AND ("state" = 'archived' OR ("state" = 'expired' AND "state_changed_at" <= now() - make_interval(days => sqlc.arg(archive_after_days)::INT))) THEN 'archived'
+      WHEN "start_date" IS NULL OR "end_date" IS NULL THEN 'expired'
+      WHEN "end_date" <= now() - make_interval(days => sqlc.arg(archive_after_days)::INT) THEN 'archived'
+      WHEN "end_date" <= now() THEN 'expired'
+      WHEN "start_date" > now() THEN 'scheduled'
+      WHEN "end_date" <= now() + make_interval(days => sqlc.arg(warning_days)::INT) THEN 'ending_soon'
+      ELSE 'active'
+    END AS state
+  FROM "ads"
+) next
+WHERE a.id = next.id
+  AND a.state <> next.state
+RETURNING a.*;
+
 -- name: IncrementAdClicks :one
 UPDATE "ads"
 SET
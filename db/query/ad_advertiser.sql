@@ -0,0 +1,43 @@
+-- name: AddAdAdvertiser :exec
+INSERT INTO "ad_advertiser" ("ad_id", "user_id")
+VALUES ($1, $2)
+ON CONFLICT ("ad_id", "user_id") DO NOTHING;
+
+-- name: RemoveAdAdvertiser :exec
+DELETE FROM "ad_advertiser"
+WHERE "ad_id" = $1 AND "user_id" = $2;
+
+-- name: CountAdvertiserAds :one
+SELECT COUNT(*)
+FROM "ad_advertiser"
+WHERE "user_id" = $1;
+
+-- name: ListAdAdvertisers :many
+SELECT u.user_id, u.username, u.email
+FROM "ad_advertiser" aa
+JOIN "user" u ON u.user_id = aa.user_id
+WHERE aa.ad_id = $1
+ORDER BY aa.created_at;
+
+-- name: ListAdvertiserAds :many
+-- The advertiser's ads with their totals between two days, the latest
+-- flights first
+SELECT a.id, a.title, a.placement, a.status, a.start_date, a.end_date,
+  COALESCE(SUM(s.impressions), 0)::INT AS impressions,
+  COALESCE(SUM(s.clicks), 0)::INT AS clicks
+FROM "ad_advertiser" aa
+JOIN "ads" a ON a.id = aa.ad_id
+LEFT JOIN "ad_stat" s ON s.ad_id = a.id
+  AND s.stat_date BETWEEN sqlc.arg(start_date) AND sqlc.arg(end_date)
+WHERE aa.user_id = sqlc.arg(user_id)
+GROUP BY a.id
+ORDER BY a.start_date DESC NULLS LAST, a.created_at DESC;
+
+-- name: ListAdvertiserDailyStats :many
+SELECT s.stat_date, a.id, a.title, s.placement, s.impressions, s.clicks
+FROM "ad_stat" s
+JOIN "ads" a ON a.id = s.ad_id
+JOIN "ad_advertiser" aa ON aa.ad_id = s.ad_id
+WHERE aa.user_id = sqlc.arg(user_id)
+  AND s.stat_date BETWEEN sqlc.arg(start_date) AND sqlc.arg(end_date)
+ORDER BY s.stat_date, a.title, s.placement;
@@ -0,0 +1,38 @@
+-- name: CreateAdPlacement :one
+INSERT INTO "ad_placement"
+("key", "name", "width", "height", "formats", "page_types", "is_active")
+VALUES
+($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: UpdateAdPlacement :one
+UPDATE "ad_placement"
+SET
+  "name" = $1,
+  "width" = $2,
+  "height" = $3,
+  "formats" = $4,
+  "page_types" = $5,
+  "is_active" = $6,
+  "updated_at" = now()
+WHERE "key" = $7
+RETURNING *;
+
+-- name: DeleteAdPlacement :exec
+DELETE FROM "ad_placement"
+WHERE "key" = $1;
+
+-- name: GetAdPlacement :one
+SELECT *
+FROM "ad_placement"
+WHERE "key" = $1;
+
+-- name: ListAdPlacements :many
+SELECT *
+FROM "ad_placement"
+ORDER BY "created_at", "key";
+
+-- name: CountAdsInPlacement :one
+SELECT COUNT(*)
+FROM "ads"
+WHERE "placement" = $1;
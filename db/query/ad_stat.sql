@@ -0,0 +1,29 @@
+-- name: RecordAdImpression :exec
+INSERT INTO "ad_stat" ("ad_id", "placement", "stat_date", "impressions")
+VALUES ($1, $2, $3, 1)
+ON CONFLICT ("ad_id", "placement", "stat_date")
+DO UPDATE SET "impressions" = "ad_stat"."impressions" + 1;
+
+-- name: RecordAdClick :exec
+INSERT INTO "ad_stat" ("ad_id", "placement", "stat_date", "clicks")
+VALUES ($1, $2, $3, 1)
+ON CONFLICT ("ad_id", "placement", "stat_date")
+DO UPDATE SET "clicks" = "ad_stat"."clicks" + 1;
+
+-- name: ListAdStats :many
+-- Totals of each ad and placement between two days, the most clicked first
+SELECT a.id, a.title, s.placement,
+  SUM(s.impressions)::INT AS impressions,
+  SUM(s.clicks)::INT AS clicks
+FROM "ad_stat" s
+JOIN "ads" a ON a.id = s.ad_id
+WHERE s.stat_date BETWEEN sqlc.arg(start_date) AND sqlc.arg(end_date)
+GROUP BY a.id, a.title, s.placement
+ORDER BY clicks DESC, impressions DESC;
+
+-- name: ListAdDailyStats :many
+SELECT s.stat_date, a.id, a.title, s.placement, s.impressions, s.clicks
+FROM "ad_stat" s
+JOIN "ads" a ON a.id = s.ad_id
+WHERE s.stat_date BETWEEN sqlc.arg(start_date) AND sqlc.arg(end_date)
+ORDER BY s.stat_date, a.title, s.placement;
@@ -0,0 +1,20 @@
+-- name: GetAdTargeting :one
+SELECT *
+FROM "ad_targeting"
+WHERE "ad_id" = $1;
+
+-- name: UpsertAdTargeting :exec
+INSERT INTO "ad_targeting"
+("ad_id", "target_categories", "exclude_categories", "target_tags", "exclude_tags", "target_page_types", "exclude_page_types", "daypart_start", "daypart_end")
+VALUES
+($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT ("ad_id")
+DO UPDATE SET
+  "target_categories" = EXCLUDED."target_categories",
+  "exclude_categories" = EXCLUDED."exclude_categories",
+  "target_tags" = EXCLUDED."target_tags",
+  "exclude_tags" = EXCLUDED."exclude_tags",
+  "target_page_types" = EXCLUDED."target_page_types",
+  "exclude_page_types" = EXCLUDED."exclude_page_types",
+  "daypart_start" = EXCLUDED."daypart_start",
+  "daypart_end" = EXCLUDED."daypart_end";
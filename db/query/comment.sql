@@ -1,20 +1,34 @@
 -- name: CreateComment :one
-INSERT INTO comment (content_id, user_id, comment_text)
-VALUES ($1, $2, $3)
+INSERT INTO comment (content_id, user_id, comment_text, is_held, held_reason, is_shadowed)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: UpdateComment :one
+-- The text being replaced is kept in comment_revision
+WITH revision AS (
+  INSERT INTO comment_revision (comment_id, comment_text)
+  SELECT comment_id, comment_text
+  FROM comment
+  WHERE comment_id = @comment_id AND is_deleted = false
+)
 UPDATE comment
 SET 
-  comment_text = $1,
-  updated_at = now()
+  comment_text = @comment_text,
+  updated_at = now(),
+  -- An edit can put a comment on hold, only a moderator takes it off
+  is_held = is_held OR @is_held::bool,
+  held_reason = CASE WHEN @is_held::bool THEN @held_reason::text ELSE held_reason END
 WHERE 
-  comment_id = $2
+  comment_id = @comment_id
   AND is_deleted = false
 RETURNING
   comment_id,
+  content_id,
+  parent_comment_id,
   comment_text,
-  updated_at;
+  created_at,
+  updated_at,
+  is_held;
 
 -- name: SoftDeleteComment :one
 UPDATE comment
@@ -42,11 +56,15 @@ SELECT
   u.role
 FROM comment cm
 JOIN "user" u ON cm.user_id = u.user_id
-WHERE cm.content_id = $1
+WHERE cm.content_id = @content_id
   AND cm.is_deleted = false
+  AND cm.is_held = false
+  AND cm.is_shadowed = false
   AND cm.parent_comment_id IS NULL
-ORDER BY cm.created_at DESC
-LIMIT $2;
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+    OR (cm.created_at, cm.comment_id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_comment_id')::uuid))
+ORDER BY cm.created_at DESC, cm.comment_id DESC
+LIMIT @limit;
 
 -- name: ListContentCommentsByScore :many
 SELECT
@@ -56,11 +74,15 @@ SELECT
   u.role
 FROM comment cm
 JOIN "user" u ON cm.user_id = u.user_id
-WHERE cm.content_id = $1
+WHERE cm.content_id = @content_id
   AND cm.is_deleted = false
+  AND cm.is_held = false
+  AND cm.is_shadowed = false
   AND cm.parent_comment_id IS NULL
-ORDER BY cm.score DESC
-LIMIT $2;
+  AND (sqlc.narg('after_score')::int IS NULL
+    OR (cm.score, cm.comment_id) < (sqlc.narg('after_score')::int, sqlc.narg('after_comment_id')::uuid))
+ORDER BY cm.score DESC, cm.comment_id DESC
+LIMIT @limit;
 
 -- name: InsertOrUpdateCommentReaction :one
 INSERT INTO comment_reaction (comment_id, user_id, reaction)
@@ -90,7 +112,7 @@ SET
   ),
   updated_at = c.updated_at  -- Explicitly keep the current value
 WHERE c.comment_id = $1
-RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id;
+RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id, is_held, held_reason, is_shadowed;
 
 -- name: FetchCommentReactions :many
 SELECT
@@ -123,22 +145,160 @@ WHERE c.content_id = $1
 -- name: GetCommentCountForContent :one
 SELECT count(*) FROM comment
 WHERE content_id = $1
-  AND is_deleted = false;
+  AND is_deleted = false
+  AND is_held = false
+  AND is_shadowed = false;
 
 -- name: CreateReply :one
-INSERT INTO comment (content_id, user_id, comment_text, parent_comment_id)
-VALUES ($1, $2, $3, $4)
+INSERT INTO comment (content_id, user_id, comment_text, parent_comment_id, is_held, held_reason, is_shadowed)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
 -- name: ListCommentReplies :many
 SELECT cm.*, u.username, u.pfp, u.role 
 FROM comment cm 
 JOIN "user" u ON cm.user_id = u.user_id
-WHERE cm.parent_comment_id = $1 AND cm.is_deleted = false
-ORDER BY cm.created_at ASC
-LIMIT $2;
+WHERE cm.parent_comment_id = @parent_comment_id AND cm.is_deleted = false AND cm.is_held = false AND cm.is_shadowed = false
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+    OR (cm.created_at, cm.comment_id) > (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_comment_id')::uuid))
+ORDER BY cm.created_at ASC, cm.comment_id ASC
+LIMIT @limit;
 
 -- name: GetReplyCount :one
 SELECT COUNT(*) 
 FROM comment
-WHERE parent_comment_id = $1 AND is_deleted = false;
+WHERE parent_comment_id = $1 AND is_deleted = false AND is_held = false AND is_shadowed = false;
+
+-- name: ListCommentAncestors :many
+-- The comment and the comments above it, the top-level comment first
+WITH RECURSIVE ancestors AS (
+  SELECT comment_id, parent_comment_id, content_id, 0 AS distance
+  FROM comment
+  WHERE comment_id = $1
+  UNION ALL
+  SELECT c.comment_id, c.parent_comment_id, c.content_id, a.distance + 1
+  FROM comment c
+  JOIN ancestors a ON c.comment_id = a.parent_comment_id
+)
+SELECT comment_id, parent_comment_id, content_id, distance::int AS distance
+FROM ancestors
+ORDER BY distance DESC;
+
+-- name: ListCommentThread :many
+-- A comment and all replies below it, each reply right after the one it answers
+WITH RECURSIVE thread AS (
+  SELECT comment_id, 0 AS depth,
+    ARRAY[to_char(created_at AT TIME ZONE 'UTC', 'YYYYMMDDHH24MISSUS') || comment_id::text] AS path
+  FROM comment
+  WHERE comment_id = @root_comment_id AND is_deleted = false AND is_held = false AND is_shadowed = false
+  UNION ALL
+  SELECT c.comment_id, t.depth + 1,
+    t.path || (to_char(c.created_at AT TIME ZONE 'UTC', 'YYYYMMDDHH24MISSUS') || c.comment_id::text)
+  FROM comment c
+  JOIN thread t ON c.parent_comment_id = t.comment_id
+  WHERE c.is_deleted = false AND c.is_held = false AND c.is_shadowed = false
+)
+SELECT
+  cm.*,
+  u.username,
+  u.pfp,
+  u.role,
+  t.depth::int AS depth,
+  (SELECT COUNT(*) FROM comment r WHERE r.parent_comment_id = cm.comment_id AND r.is_deleted = false AND r.is_held = false AND r.is_shadowed = false) AS reply_count
+FROM thread t
+JOIN comment cm ON cm.comment_id = t.comment_id
+JOIN "user" u ON cm.user_id = u.user_id
+ORDER BY t.path
+LIMIT @limit;
+
+-- name: CountDuplicateComments :one
+-- Copies of the text posted on other articles during the last day
+SELECT COUNT(*)
+FROM comment
+WHERE md5(lower(comment_text)) = md5(lower(@comment_text::text))
+  AND content_id <> @content_id
+  AND created_at > now() - interval '1 day'
+  AND is_deleted = false;
+
+-- name: ListHeldComments :many
+SELECT
+  cm.*,
+  u.username,
+  u.pfp,
+  c.title AS content_title
+FROM comment cm
+JOIN "user" u ON cm.user_id = u.user_id
+JOIN content c ON cm.content_id = c.content_id
+WHERE cm.is_held = true
+  AND cm.is_deleted = false
+ORDER BY cm.created_at ASC
+LIMIT $1;
+
+-- name: CountHeldComments :one
+SELECT COUNT(*)
+FROM comment
+WHERE is_held = true
+  AND is_deleted = false;
+
+-- name: ApproveComment :one
+UPDATE comment
+SET
+  is_held = false,
+  held_reason = ''
+WHERE comment_id = $1
+  AND is_held = true
+RETURNING *;
+
+-- name: ListCommentRevisions :many
+SELECT * FROM comment_revision
+WHERE comment_id = $1
+ORDER BY created_at ASC;
+
+-- name: ListShadowedComments :many
+-- The hidden comments of a shadow-banned user under an article or a comment,
+-- shown only to their author
+SELECT
+  cm.*,
+  u.username,
+  u.pfp,
+  u.role
+FROM comment cm
+JOIN "user" u ON cm.user_id = u.user_id
+WHERE cm.content_id = @content_id
+  AND cm.user_id = @user_id
+  AND cm.parent_comment_id IS NOT DISTINCT FROM sqlc.narg('parent_comment_id')::uuid
+  AND cm.is_shadowed = true
+  AND cm.is_deleted = false
+ORDER BY cm.created_at DESC
+LIMIT @limit;
+
+-- name: UnshadowComments :many
+-- Publishes the comments posted under a shadow-ban that is no longer in
+-- force, of user_id or of every user when it is null, and counts them on
+-- their articles
+WITH unshadowed AS (
+  UPDATE comment c
+  SET is_shadowed = false
+  WHERE c.is_shadowed = true
+    AND (sqlc.narg('user_id')::uuid IS NULL OR c.user_id = sqlc.narg('user_id')::uuid)
+    AND NOT EXISTS (
+      SELECT 1 FROM user_sanction s
+      WHERE s.user_id = c.user_id
+        AND s.kind = 'shadowban'
+        AND s.lifted_at IS NULL
+        AND (s.expires_at IS NULL OR s.expires_at > now())
+    )
+  RETURNING c.comment_id, c.content_id, c.parent_comment_id, c.is_deleted, c.is_held
+), counted AS (
+  UPDATE content
+  SET comment_count = comment_count + u.count
+  FROM (
+    SELECT content_id, COUNT(*)::INT AS count
+    FROM unshadowed
+    WHERE is_deleted IS NOT TRUE AND is_held = false
+    GROUP BY content_id
+  ) u
+  WHERE content.content_id = u.content_id
+)
+SELECT comment_id, content_id, parent_comment_id
+FROM unshadowed;
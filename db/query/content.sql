@@ -33,10 +33,36 @@ RETURNING *;
 UPDATE content
 SET
     thumbnail = $2,
+    thumbnail_focal_x = $3,
+    thumbnail_focal_y = $4,
     updated_at = now()
 WHERE content_id = $1
 RETURNING *;
 
+-- name: UpdateThumbnailFocalPoint :exec
+UPDATE content
+SET
+    thumbnail_focal_x = $1,
+    thumbnail_focal_y = $2
+WHERE content_id = $3
+  AND thumbnail = $4;
+
+-- name: UpdateContentSponsor :exec
+UPDATE content
+SET
+    is_sponsored = $2,
+    sponsor_name = $3,
+    sponsor_logo = $4,
+    updated_at = now()
+WHERE content_id = $1;
+
+-- name: ReplaceThumbnail :exec
+UPDATE content
+SET thumbnail = @new_thumbnail,
+    thumbnail_focal_x = @thumbnail_focal_x,
+    thumbnail_focal_y = @thumbnail_focal_y
+WHERE thumbnail = @old_thumbnail;
+
 -- name: PublishContent :one
 UPDATE content
 SET
@@ -437,6 +463,7 @@ FROM content c
 JOIN category cat ON c.category_id = cat.category_id
 WHERE c.status = 'published'
   AND c.is_deleted = false
+  AND c.is_sponsored = false
   AND c.published_at >= $1
 ORDER BY total_interactions DESC
 LIMIT $2;
@@ -454,6 +481,7 @@ FROM content c
 WHERE c.content_id <> $1
   AND c.status = 'published'
   AND c.is_deleted = false
+  AND c.is_sponsored = false
   AND c.category_id = (SELECT category_id FROM content WHERE content_id = $1)
   AND EXISTS (
       SELECT 1
@@ -0,0 +1,35 @@
+-- name: SetCategoryEngagementOverride :one
+-- Sets the category's rule for the setting, replacing the one it had
+INSERT INTO "engagement_override" ("category_id", "setting", "enabled", "after_hours")
+VALUES (sqlc.arg(category_id), sqlc.arg(setting), sqlc.arg(enabled), sqlc.narg(after_hours))
+ON CONFLICT ("category_id", "setting") WHERE "category_id" IS NOT NULL
+DO UPDATE SET "enabled" = EXCLUDED."enabled", "after_hours" = EXCLUDED."after_hours", "created_at" = now()
+RETURNING *;
+
+-- name: SetContentEngagementOverride :one
+-- Sets the article's rule for the setting, replacing the one it had
+INSERT INTO "engagement_override" ("content_id", "setting", "enabled", "after_hours")
+VALUES (sqlc.arg(content_id), sqlc.arg(setting), sqlc.arg(enabled), sqlc.narg(after_hours))
+ON CONFLICT ("content_id", "setting") WHERE "content_id" IS NOT NULL
+DO UPDATE SET "enabled" = EXCLUDED."enabled", "after_hours" = EXCLUDED."after_hours", "created_at" = now()
+RETURNING *;
+
+-- name: ListCategoryEngagementOverrides :many
+SELECT * FROM "engagement_override"
+WHERE "category_id" = sqlc.arg(category_id)
+ORDER BY "setting";
+
+-- name: ListContentEngagementOverrides :many
+SELECT * FROM "engagement_override"
+WHERE "content_id" = sqlc.arg(content_id)
+ORDER BY "setting";
+
+-- name: ListEngagementOverrides :many
+-- Every rule, there are few enough to resolve a page's articles in memory
+SELECT * FROM "engagement_override"
+ORDER BY "created_at";
+
+-- name: DeleteEngagementOverride :one
+DELETE FROM "engagement_override"
+WHERE "id" = sqlc.arg(id)
+RETURNING *;
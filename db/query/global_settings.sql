@@ -16,6 +16,16 @@ SET
     "disable_ads" = $5
 WHERE "global_settings_id" = (SELECT "global_settings_id" FROM "global_settings" LIMIT 1);
 
+-- name: UpdateWatermarkSettings :exec
+UPDATE "global_settings"
+SET
+    "watermark_enabled" = $1,
+    "watermark_logo" = $2,
+    "watermark_position" = $3,
+    "watermark_opacity" = $4,
+    "watermark_scale" = $5
+WHERE "global_settings_id" = (SELECT "global_settings_id" FROM "global_settings" LIMIT 1);
+
 -- name: ResetGlobalSettings :exec
 UPDATE "global_settings"
 SET
@@ -31,3 +41,19 @@ SELECT EXISTS (
   SELECT 1
   FROM global_settings
 );
+
+-- name: UpdateCommentSettings :exec
+UPDATE "global_settings"
+SET
+    "comment_max_depth" = $1,
+    "comment_max_links" = $2,
+    "comment_blocklist" = $3,
+    "comment_edit_window" = $4
+WHERE "global_settings_id" = (SELECT "global_settings_id" FROM "global_settings" LIMIT 1);
+
+-- name: UpdateSiteMode :exec
+UPDATE "global_settings"
+SET
+    "site_mode" = $1,
+    "site_mode_message" = $2
+WHERE "global_settings_id" = (SELECT "global_settings_id" FROM "global_settings" LIMIT 1);
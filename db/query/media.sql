@@ -1,7 +1,7 @@
 -- name: InsertMedia :one
-INSERT INTO media (content_id, media_type, media_url, media_caption, media_order)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING media_id, content_id, media_type, media_url, media_caption, media_order;
+INSERT INTO media (content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark;
 
 -- name: UpdateMedia :one
 UPDATE media
@@ -9,19 +9,33 @@ SET media_url = $1,
     media_caption = $2,
     media_order = $3
 WHERE media_id = $4
-RETURNING media_id, content_id, media_type, media_url, media_caption, media_order;
+RETURNING media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark;
+
+-- name: UpdateMediaCaption :one
+UPDATE media
+SET media_caption = $1,
+    media_credit = $2
+WHERE media_id = $3
+RETURNING media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark;
+
+-- name: UpdateMediaFocalPoint :one
+UPDATE media
+SET focal_x = $1,
+    focal_y = $2
+WHERE media_id = $3
+RETURNING media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark;
 
 -- name: DeleteMedia :exec
 DELETE FROM media
 WHERE media_id = $1;
 
 -- name: GetMediaByID :one
-SELECT media_id, content_id, media_type, media_url, media_caption, media_order
+SELECT media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
 FROM media
 WHERE media_id = $1;
 
 -- name: ListMediaForContent :many
-SELECT media_id, content_id, media_type, media_url, media_caption, media_order
+SELECT media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
 FROM media
 WHERE content_id = $1
 ORDER BY media_order ASC;
@@ -38,4 +52,88 @@ FROM (
 ) AS data(media_id, new_order)
 WHERE media.media_id = data.media_id;
 
+-- name: UpdateMediaPhash :exec
+UPDATE media
+SET phash = $1
+WHERE media_id = $2;
+
+-- name: ListUnhashedImages :many
+SELECT media_id, media_url
+FROM media
+WHERE phash IS NULL
+  AND media_type = 'image'
+  AND NOT EXISTS (
+    SELECT 1 FROM media_hash_failure f WHERE f.media_id = media.media_id
+  );
+
+-- name: MarkMediaHashFailed :exec
+INSERT INTO media_hash_failure (media_id)
+VALUES ($1)
+ON CONFLICT (media_id) DO NOTHING;
+
+-- name: ListSimilarMedia :many
+SELECT
+  m.media_id,
+  m.content_id,
+  m.media_url,
+  m.media_caption,
+  m.focal_x,
+  m.focal_y,
+  c.title,
+  bit_count((m.phash # @phash::bigint)::bit(64))::int AS distance
+FROM media m
+JOIN content c ON m.content_id = c.content_id
+WHERE m.phash IS NOT NULL
+  AND m.media_url <> @media_url
+  AND bit_count((m.phash # @phash::bigint)::bit(64)) <= @max_distance::int
+ORDER BY distance ASC
+LIMIT @max_results;
+
+-- name: ListHashedMedia :many
+SELECT
+  m.media_id,
+  m.content_id,
+  m.media_url,
+  m.focal_x,
+  m.focal_y,
+  m.phash,
+  c.title
+FROM media m
+JOIN content c ON m.content_id = c.content_id
+WHERE m.phash IS NOT NULL
+ORDER BY m.media_url;
 
+-- name: GetMediaByUrl :one
+SELECT media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
+FROM media
+WHERE media_url = $1
+LIMIT 1;
+
+-- name: CountMediaByUrl :one
+SELECT COUNT(*)
+FROM media
+WHERE media_url = $1;
+
+-- name: ListContentIDsByMediaUrl :many
+SELECT content_id
+FROM media
+WHERE media_url = $1
+UNION
+SELECT content_id
+FROM content
+WHERE thumbnail = $1;
+
+-- name: ReplaceMediaUrl :exec
+UPDATE media
+SET media_url = @new_url,
+    focal_x = @focal_x,
+    focal_y = @focal_y,
+    phash = @phash,
+    original_path = @original_path,
+    watermark = @watermark
+WHERE media_url = @old_url;
+
+-- name: ListMediaWithOriginals :many
+SELECT media_id, media_url, original_path, watermark
+FROM media
+WHERE original_path <> '';
@@ -0,0 +1,68 @@
+-- name: CreateNotification :execrows
+INSERT INTO "notification" ("user_id", "actor_id", "comment_id", "kind")
+VALUES ($1, $2, $3, $4)
+ON CONFLICT ("user_id", "comment_id") DO NOTHING;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM "notification"
+WHERE "user_id" = $1 AND "is_read" = false;
+
+-- name: ListNotifications :many
+SELECT
+  n.notification_id,
+  n.comment_id,
+  n.kind,
+  n.is_read,
+  n.created_at,
+  a.username AS actor_username,
+  a.pfp AS actor_pfp,
+  cm.comment_text,
+  c.title AS content_title
+FROM "notification" n
+JOIN "user" a ON n.actor_id = a.user_id
+JOIN comment cm ON n.comment_id = cm.comment_id
+JOIN content c ON cm.content_id = c.content_id
+WHERE n.user_id = $1
+  AND cm.is_deleted = false
+ORDER BY n.created_at DESC
+LIMIT $2;
+
+-- name: GetNotificationComment :one
+SELECT "comment_id"
+FROM "notification"
+WHERE "notification_id" = $1 AND "user_id" = $2;
+
+-- name: MarkNotificationRead :one
+UPDATE "notification"
+SET "is_read" = true
+WHERE "notification_id" = $1 AND "user_id" = $2
+RETURNING "comment_id";
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE "notification"
+SET "is_read" = true
+WHERE "user_id" = $1 AND "is_read" = false;
+
+-- name: GetNotificationSettings :one
+-- Readers without a row get every email
+SELECT
+  COALESCE(ns.email_replies, true)::bool AS email_replies,
+  COALESCE(ns.email_mentions, true)::bool AS email_mentions
+FROM "user" u
+LEFT JOIN "notification_settings" ns ON ns.user_id = u.user_id
+WHERE u.user_id = $1;
+
+-- name: UpsertNotificationSettings :exec
+INSERT INTO "notification_settings" ("user_id", "email_replies", "email_mentions")
+VALUES ($1, $2, $3)
+ON CONFLICT ("user_id") DO UPDATE
+SET "email_replies" = EXCLUDED.email_replies,
+    "email_mentions" = EXCLUDED.email_mentions;
+
+-- name: GetUsersByUsernames :many
+SELECT user_id, username, email
+FROM "user"
+WHERE LOWER(username) = ANY(@usernames::text[])
+  AND is_deleted = false
+  AND banned = false;
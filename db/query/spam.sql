@@ -0,0 +1,22 @@
+-- name: CreateSpamTraining :execrows
+INSERT INTO "spam_training" ("comment_id", "is_spam")
+VALUES ($1, $2)
+ON CONFLICT ("comment_id") DO NOTHING;
+
+-- name: TrainSpamTokens :exec
+INSERT INTO "spam_token" ("token", "spam_count", "ham_count")
+SELECT token, @is_spam::bool::int, (NOT @is_spam::bool)::int
+FROM unnest(@tokens::text[]) AS token
+ON CONFLICT ("token") DO UPDATE
+SET "spam_count" = spam_token.spam_count + EXCLUDED.spam_count,
+    "ham_count" = spam_token.ham_count + EXCLUDED.ham_count;
+
+-- name: GetSpamTokens :many
+SELECT * FROM "spam_token"
+WHERE "token" = ANY(@tokens::text[]);
+
+-- name: GetSpamTrainingCounts :one
+SELECT
+  COUNT(*) FILTER (WHERE is_spam) AS spam_count,
+  COUNT(*) FILTER (WHERE NOT is_spam) AS ham_count
+FROM "spam_training";
@@ -0,0 +1,16 @@
+-- name: RecordSponsoredView :exec
+-- Counts a unique view of the article if it is sponsored
+INSERT INTO "sponsored_stat" ("content_id", "stat_date", "views")
+SELECT "content_id", sqlc.arg(stat_date)::DATE, 1
+FROM "content"
+WHERE "content_id" = sqlc.arg(content_id)
+  AND "is_sponsored" = true
+ON CONFLICT ("content_id", "stat_date")
+DO UPDATE SET "views" = "sponsored_stat"."views" + 1;
+
+-- name: ListSponsoredDailyStats :many
+SELECT "stat_date", "views"
+FROM "sponsored_stat"
+WHERE "content_id" = sqlc.arg(content_id)
+  AND "stat_date" BETWEEN sqlc.arg(start_date) AND sqlc.arg(end_date)
+ORDER BY "stat_date";
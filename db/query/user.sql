@@ -39,6 +39,11 @@ UPDATE "user"
 SET banned = false 
 WHERE user_id = $1;
 
+-- name: SetUserRole :exec
+UPDATE "user" 
+SET role = $2 
+WHERE user_id = $1;
+
 -- name: DeleteUser :exec
 UPDATE "user" 
 SET email = CONCAT('deleted_', user_id, '@example.com'), 
@@ -0,0 +1,32 @@
+-- name: CreateUserSanction :one
+INSERT INTO "user_sanction" ("user_id", "kind", "reason", "expires_at", "created_by")
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListActiveUserSanctions :many
+-- Sanctions in force, the newest first
+SELECT * FROM "user_sanction"
+WHERE user_id = $1
+  AND lifted_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now())
+ORDER BY created_at DESC;
+
+-- name: ListUserSanctions :many
+SELECT * FROM "user_sanction"
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: LiftUserSanction :one
+UPDATE "user_sanction"
+SET lifted_at = now()
+WHERE sanction_id = $1
+  AND lifted_at IS NULL
+RETURNING *;
+
+-- name: LiftUserSanctionsByKind :exec
+UPDATE "user_sanction"
+SET lifted_at = now()
+WHERE user_id = $1
+  AND kind = $2
+  AND lifted_at IS NULL;
@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker stops calling Redis after threshold consecutive failures.
+// Once cooldown has passed a single call is let through to probe it, success
+// closes the breaker again.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// allow reports whether Redis should be called.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// success records a working call and reports whether it closed an open breaker.
+func (b *circuitBreaker) success() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	recovered := b.failures >= b.threshold
+	b.failures = 0
+	b.probing = false
+	return recovered
+}
+
+// failure records a failed call and reports whether it just opened the breaker.
+func (b *circuitBreaker) failure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.failures < b.threshold {
+		return false
+	}
+
+	b.openUntil = time.Now().Add(b.cooldown)
+	return b.failures == b.threshold
+}
+
+// abort ends a probe without a verdict, e.g. when the request was cancelled.
+func (b *circuitBreaker) abort() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
@@ -0,0 +1,274 @@
+package redis
+
+import (
+	"container/list"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// localCache is the in-process tier in front of Redis: a byte bounded LRU of
+// encoded values that also knows their tags, so invalidation works without Redis.
+type localCache struct {
+	maxBytes int64
+
+	mu      sync.Mutex
+	size    int64
+	order   *list.List // front is the most recently used entry
+	entries map[string]*list.Element
+	tags    map[string]map[string]struct{}
+}
+
+type localEntry struct {
+	key     string
+	data    []byte
+	tags    []string
+	expires time.Time
+}
+
+func newLocalCache(maxBytes int64) *localCache {
+	return &localCache{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		tags:     make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *localCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*localEntry)
+	if time.Now().After(entry.expires) {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.data, true
+}
+
+func (c *localCache) set(key string, data []byte, expiration time.Duration, tags ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+
+	// Larger than the whole cache, it would only evict everything else
+	if int64(len(data)) > c.maxBytes {
+		return
+	}
+
+	elem := c.order.PushFront(&localEntry{
+		key:     key,
+		data:    data,
+		tags:    tags,
+		expires: time.Now().Add(expiration),
+	})
+	c.entries[key] = elem
+	c.size += int64(len(data))
+
+	for _, tag := range tags {
+		if c.tags[tag] == nil {
+			c.tags[tag] = make(map[string]struct{})
+		}
+		c.tags[tag][key] = struct{}{}
+	}
+
+	for c.size > c.maxBytes && c.order.Len() > 0 {
+		c.remove(c.order.Back())
+	}
+}
+
+// setNX stores key only if it isn't there yet (or has expired) and reports whether it did.
+func (c *localCache) setNX(key string, expiration time.Duration) bool {
+	if _, ok := c.get(key); ok {
+		return false
+	}
+	c.set(key, nil, expiration)
+	return true
+}
+
+// incr adds one to the counter at key, keeping the expiry it was created with.
+func (c *localCache) incr(key string, expiration time.Duration) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*localEntry)
+		if time.Now().Before(entry.expires) {
+			n, _ := strconv.ParseInt(string(entry.data), 10, 64)
+			n++
+			data := []byte(strconv.FormatInt(n, 10))
+			c.size += int64(len(data) - len(entry.data))
+			entry.data = data
+			c.order.MoveToFront(elem)
+			return n
+		}
+		c.remove(elem)
+	}
+
+	data := []byte("1")
+	elem := c.order.PushFront(&localEntry{
+		key:     key,
+		data:    data,
+		expires: time.Now().Add(expiration),
+	})
+	c.entries[key] = elem
+	c.size += int64(len(data))
+
+	for c.size > c.maxBytes && c.order.Len() > 1 {
+		c.remove(c.order.Back())
+	}
+	return 1
+}
+
+// count returns the counter incr keeps at key, 0 if there is none.
+func (c *localCache) count(key string) int64 {
+	data, ok := c.get(key)
+	if !ok {
+		return 0
+	}
+	n, _ := strconv.ParseInt(string(data), 10, 64)
+	return n
+}
+
+func (c *localCache) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+func (c *localCache) invalidateTag(tag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.tags[tag] {
+		if elem, ok := c.entries[key]; ok {
+			c.remove(elem)
+		}
+	}
+	delete(c.tags, tag)
+}
+
+// deleteByPattern takes the same glob patterns as Redis KEYS and SCAN.
+func (c *localCache) deleteByPattern(pattern string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, elem := range c.entries {
+		if globMatch(pattern, key) {
+			c.remove(elem)
+		}
+	}
+}
+
+// globMatch matches key the way Redis does: * is any run of bytes, slashes
+// included, ? any one byte, [abc], [a-z] and [^a] a class and \ escapes the
+// next byte.
+func globMatch(pattern, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if globMatch(pattern[1:], key[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(key) == 0 {
+				return false
+			}
+			key = key[1:]
+			pattern = pattern[1:]
+		case '[':
+			if len(key) == 0 {
+				return false
+			}
+			var matched bool
+			matched, pattern = globClass(pattern[1:], key[0])
+			if !matched {
+				return false
+			}
+			key = key[1:]
+		default:
+			if pattern[0] == '\\' && len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			if len(key) == 0 || pattern[0] != key[0] {
+				return false
+			}
+			key = key[1:]
+			pattern = pattern[1:]
+		}
+	}
+	return len(key) == 0
+}
+
+// globClass matches b against the class that starts pattern, right after
+// its [, and returns the pattern after the class. An unclosed class runs to
+// the end of the pattern, like in Redis.
+func globClass(pattern string, b byte) (bool, string) {
+	negate := len(pattern) > 0 && pattern[0] == '^'
+	if negate {
+		pattern = pattern[1:]
+	}
+
+	matched := false
+	for len(pattern) > 0 && pattern[0] != ']' {
+		switch {
+		case pattern[0] == '\\' && len(pattern) > 1:
+			matched = matched || pattern[1] == b
+			pattern = pattern[2:]
+		case len(pattern) > 2 && pattern[1] == '-' && pattern[2] != ']':
+			lo, hi := pattern[0], pattern[2]
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			matched = matched || (b >= lo && b <= hi)
+			pattern = pattern[3:]
+		default:
+			matched = matched || pattern[0] == b
+			pattern = pattern[1:]
+		}
+	}
+	if len(pattern) > 0 {
+		pattern = pattern[1:]
+	}
+
+	return matched != negate, pattern
+}
+
+// remove must be called with mu held.
+func (c *localCache) remove(elem *list.Element) {
+	entry := elem.Value.(*localEntry)
+
+	c.order.Remove(elem)
+	delete(c.entries, entry.key)
+	c.size -= int64(len(entry.data))
+
+	for _, tag := range entry.tags {
+		delete(c.tags[tag], entry.key)
+		if len(c.tags[tag]) == 0 {
+			delete(c.tags, tag)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		key     string
+		match   bool
+	}{
+		{"page:*", "page:false:/vesti/2025/03/clanak", true},
+		{"page:false:/vesti/*", "page:false:/vesti/2025/03/clanak", true},
+		{"page:*:/vesti/*", "page:true:/vesti/clanak", true},
+		{"page:*", "tag:page:home", false},
+		{"*", "", true},
+		{"page:**", "page:", true},
+		{"content:?", "content:1", true},
+		{"content:?", "content:12", false},
+		{"content:[0-9]", "content:7", true},
+		{"content:[0-9]", "content:a", false},
+		{"content:[^0-9]", "content:a", true},
+		{"content:[abc]", "content:b", true},
+		{"content:[abc]", "content:d", false},
+		{`content:\*`, "content:*", true},
+		{`content:\*`, "content:1", false},
+		{"content:[a", "content:a", true},
+		{"exact", "exact", true},
+		{"exact", "exactly", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.match, globMatch(tc.pattern, tc.key), "%q ~ %q", tc.pattern, tc.key)
+	}
+}
+
+func TestLocalCacheDeleteByPattern(t *testing.T) {
+	c := newLocalCache(1 << 20)
+	c.set("page:false:/vesti/clanak", []byte("a"), time.Minute)
+	c.set("page:true:/vesti/clanak", []byte("b"), time.Minute)
+	c.set("content:1", []byte("c"), time.Minute)
+
+	c.deleteByPattern("page:*")
+
+	_, ok := c.get("page:false:/vesti/clanak")
+	require.False(t, ok)
+	_, ok = c.get("page:true:/vesti/clanak")
+	require.False(t, ok)
+	_, ok = c.get("content:1")
+	require.True(t, ok)
+}
+
+func TestLocalCacheCount(t *testing.T) {
+	c := newLocalCache(1 << 20)
+
+	require.Zero(t, c.count("ad:served"))
+
+	c.incr("ad:served", time.Hour)
+	c.incr("ad:served", time.Hour)
+	require.Equal(t, int64(2), c.count("ad:served"))
+
+	// Reading the counter doesn't move it
+	require.Equal(t, int64(2), c.count("ad:served"))
+	require.Equal(t, int64(3), c.incr("ad:served", time.Hour))
+}
@@ -0,0 +1,21 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Publish sends message to everyone subscribed to channel, on every instance.
+func (c *CacheService) Publish(ctx context.Context, channel string, message []byte) error {
+	return c.do(ctx, func() error {
+		return c.client.Publish(ctx, channel, message).Err()
+	})
+}
+
+// PSubscribe subscribes to the channels matching pattern. The subscription
+// reconnects by itself after Redis goes away, messages published meanwhile
+// are lost.
+func (c *CacheService) PSubscribe(ctx context.Context, pattern string) *redis.PubSub {
+	return c.client.PSubscribe(ctx, pattern)
+}
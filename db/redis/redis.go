@@ -6,8 +6,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/gob"
+	"errors"
 	"fmt"
+	"log"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -23,35 +27,133 @@ func InitRedis() {
 		Addr:     os.Getenv("REDIS_ADDR"), // should be "mn-redis:6379"
 		Password: os.Getenv("REDIS_PASSWORD"),
 		DB:       0, // default
+		// Fail fast, the cache falls back to memory instead of holding up requests
+		DialTimeout:  2 * time.Second,
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		MaxRetries:   1,
 	})
 }
 
-// CacheService provides methods for working with Redis cache
+const (
+	// Another instance's invalidations only reach Redis, so in-process copies
+	// are kept briefly
+	localCacheMaxTTL = time.Minute
+
+	// Redis is skipped for breakerCooldown after breakerThreshold failures in a row
+	breakerThreshold = 5
+	breakerCooldown  = 10 * time.Second
+
+	// Invalidations made while Redis is down are replayed when it's back
+	maxPendingInvalidations = 10000
+)
+
+// errUnavailable means Redis was skipped because the circuit breaker is open
+var errUnavailable = errors.New("redis unavailable")
+
+// CacheService is a two-tier cache: an in-process LRU in front of Redis. When
+// Redis fails it keeps working from memory alone and catches Redis up once it
+// answers again, so a cache outage never takes the site down.
 type CacheService struct {
-	client *redis.Client
+	client  *redis.Client
+	local   *localCache
+	breaker *circuitBreaker
+
+	mu              sync.Mutex
+	pendingKeys     map[string]struct{}
+	pendingTags     map[string]struct{}
+	pendingPatterns map[string]struct{}
 }
 
-// NewCacheService creates a new cache service
+// NewCacheService creates a new cache service. LOCAL_CACHE_MAX_MB bounds the
+// in-process tier (default 64).
 func NewCacheService(client *redis.Client) *CacheService {
+	maxMB := int64(64)
+	if v := os.Getenv("LOCAL_CACHE_MAX_MB"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+			maxMB = n
+		}
+	}
+
 	return &CacheService{
-		client: client,
+		client:          client,
+		local:           newLocalCache(maxMB << 20),
+		breaker:         newCircuitBreaker(breakerThreshold, breakerCooldown),
+		pendingKeys:     make(map[string]struct{}),
+		pendingTags:     make(map[string]struct{}),
+		pendingPatterns: make(map[string]struct{}),
+	}
+}
+
+// do runs fn against Redis unless the breaker is open and records the outcome.
+func (c *CacheService) do(ctx context.Context, fn func() error) error {
+	if !c.breaker.allow() {
+		return errUnavailable
+	}
+
+	err := fn()
+	switch {
+	case err == nil || err == redis.Nil:
+		if c.breaker.success() {
+			log.Print("Redis is back, replaying invalidations made without it")
+			go c.replayInvalidations()
+		}
+	case ctx.Err() != nil:
+		// The request went away, that says nothing about Redis
+		c.breaker.abort()
+	default:
+		if c.breaker.failure() {
+			log.Printf("Redis unavailable, caching in memory only: %v", err)
+		}
+	}
+
+	return err
+}
+
+func localTTL(expiration time.Duration) time.Duration {
+	if expiration <= 0 || expiration > localCacheMaxTTL {
+		return localCacheMaxTTL
+	}
+	return expiration
+}
+
+func encode(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, fmt.Errorf("encode error: %w", err)
 	}
+	return buf.Bytes(), nil
 }
 
 // Get retrieves data from cache and decodes it into the provided destination
-// Returns true if found in cache, false if not found
+// Returns true if found in cache, false if not found. What Redis returns is
+// kept in memory too, so reads keep working for a while if Redis goes down.
 func (c *CacheService) Get(ctx context.Context, key string, dest interface{}) (bool, error) {
-	data, err := c.client.Get(ctx, key).Bytes()
-	if err == redis.Nil {
-		// Key doesn't exist
-		return false, nil
-	} else if err != nil {
-		// Some other Redis error
-		return false, fmt.Errorf("redis get error: %w", err)
+	data, ok := c.local.get(key)
+	if !ok {
+		err := c.do(ctx, func() error {
+			pipe := c.client.Pipeline()
+			get := pipe.Get(ctx, key)
+			ttl := pipe.PTTL(ctx, key)
+			if _, err := pipe.Exec(ctx); err != nil {
+				return err
+			}
+
+			data, _ = get.Bytes()
+			c.local.set(key, data, localTTL(ttl.Val()))
+			return nil
+		})
+		if err == redis.Nil || err == errUnavailable {
+			// Key doesn't exist, or Redis is down and it isn't in memory either
+			return false, nil
+		} else if err != nil {
+			// Some other Redis error
+			return false, fmt.Errorf("redis get error: %w", err)
+		}
 	}
 
 	// Decode the data into the destination
-	err = gob.NewDecoder(bytes.NewReader(data)).Decode(dest)
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(dest)
 	if err != nil {
 		return false, fmt.Errorf("decode error: %w", err)
 	}
@@ -61,45 +163,223 @@ func (c *CacheService) Get(ctx context.Context, key string, dest interface{}) (b
 
 // Set stores data in cache with the specified expiration
 func (c *CacheService) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
-	var buf bytes.Buffer
-	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
-		return fmt.Errorf("encode error: %w", err)
-	}
+	return c.SetWithTags(ctx, key, value, expiration)
+}
+
+// Delete removes a key from the cache
+func (c *CacheService) Delete(ctx context.Context, key string) error {
+	c.local.delete(key)
 
-	err := c.client.Set(ctx, key, buf.Bytes(), expiration).Err()
+	err := c.do(ctx, func() error {
+		return c.client.Del(ctx, key).Err()
+	})
 	if err != nil {
-		return fmt.Errorf("redis set error: %w", err)
+		c.queueInvalidation(c.pendingKeys, key)
+		if err != errUnavailable {
+			return fmt.Errorf("redis delete error: %w", err)
+		}
 	}
-
 	return nil
 }
 
-// Delete removes a key from the cache
-func (c *CacheService) Delete(ctx context.Context, key string) error {
-	err := c.client.Del(ctx, key).Err()
+// TryLock sets key if it doesn't exist yet and reports whether it did, so
+// only one caller does a piece of work. The lock expires by itself after ttl.
+// Without Redis the lock only holds within this process.
+func (c *CacheService) TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	var ok bool
+	err := c.do(ctx, func() (err error) {
+		ok, err = c.client.SetNX(ctx, key, 1, ttl).Result()
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("redis delete error: %w", err)
+		return c.local.setNX(key, ttl), nil
 	}
-	return nil
+	return ok, nil
 }
 
-// DeleteByPattern removes all keys matching the pattern
-func (c *CacheService) DeleteByPattern(ctx context.Context, pattern string) error {
-	keys, err := c.client.Keys(ctx, pattern).Result()
+// Incr adds one to the counter at key and returns the new count. The counter
+// expires ttl after it was first incremented, both happen in one transaction
+// so a counter can't be left without an expiry. Without Redis it only counts
+// within this process.
+func (c *CacheService) Incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	var n int64
+	err := c.do(ctx, func() error {
+		pipe := c.client.TxPipeline()
+		incr := pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, ttl)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return err
+		}
+
+		n = incr.Val()
+		return nil
+	})
+	if err != nil {
+		return c.local.incr(key, ttl), nil
+	}
+	return n, nil
+}
+
+// Count returns the counter Incr keeps at key, 0 if there is none yet.
+func (c *CacheService) Count(ctx context.Context, key string) (int64, error) {
+	var n int64
+	err := c.do(ctx, func() (err error) {
+		n, err = c.client.Get(ctx, key).Int64()
+		return err
+	})
+	switch {
+	case err == nil:
+		return n, nil
+	case err == redis.Nil:
+		return 0, nil
+	default:
+		// Incr counted in memory when Redis failed
+		return c.local.count(key), nil
+	}
+}
+
+// tagKey is the set that records which cache keys carry tag
+func tagKey(tag string) string {
+	return "tag:" + tag
+}
+
+// SetWithTags stores data like Set and records the key under each tag, so
+// everything derived from e.g. one article can be dropped with InvalidateTags.
+func (c *CacheService) SetWithTags(ctx context.Context, key string, value interface{}, expiration time.Duration, tags ...string) error {
+	data, err := encode(value)
 	if err != nil {
-		return fmt.Errorf("redis keys error: %w", err)
+		return err
 	}
 
-	if len(keys) > 0 {
-		err = c.client.Del(ctx, keys...).Err()
+	c.local.set(key, data, localTTL(expiration), tags...)
+
+	err = c.do(ctx, func() error {
+		pipe := c.client.TxPipeline()
+		pipe.Set(ctx, key, data, expiration)
+		for _, tag := range tags {
+			pipe.SAdd(ctx, tagKey(tag), key)
+			// The set has to outlive its longest lived key: NX sets the TTL on a
+			// new set, GT only ever extends it afterwards
+			pipe.ExpireNX(ctx, tagKey(tag), expiration)
+			pipe.ExpireGT(ctx, tagKey(tag), expiration)
+		}
+		_, err := pipe.Exec(ctx)
+		return err
+	})
+	if err != nil && err != errUnavailable {
+		return fmt.Errorf("redis set error: %w", err)
+	}
+
+	return nil
+}
+
+// invalidateTagScript deletes the keys of a tag and the tag set itself in one
+// step, so a key tagged while it runs can't be left behind untracked. It
+// returns the keys, copies Get kept in memory don't know their tags.
+var invalidateTagScript = redis.NewScript(`
+local keys = redis.call('SMEMBERS', KEYS[1])
+for i = 1, #keys, 500 do
+	redis.call('DEL', unpack(keys, i, math.min(i + 499, #keys)))
+end
+redis.call('DEL', KEYS[1])
+return keys
+`)
+
+// InvalidateTags removes every key stored with any of the tags. It only
+// touches the keys of those tags, unlike a pattern delete.
+func (c *CacheService) InvalidateTags(ctx context.Context, tags ...string) error {
+	var firstErr error
+	for _, tag := range tags {
+		c.local.invalidateTag(tag)
+
+		err := c.do(ctx, func() error {
+			keys, err := invalidateTagScript.Run(ctx, c.client, []string{tagKey(tag)}).StringSlice()
+			for _, key := range keys {
+				c.local.delete(key)
+			}
+			return err
+		})
 		if err != nil {
-			return fmt.Errorf("redis delete error: %w", err)
+			c.queueInvalidation(c.pendingTags, tag)
+			if err != errUnavailable && firstErr == nil {
+				firstErr = fmt.Errorf("redis invalidate tag error: %w", err)
+			}
+		}
+	}
+	return firstErr
+}
+
+// DeleteByPattern removes all keys matching the pattern. It walks the whole
+// keyspace with SCAN, so prefer tags and keep this for one-off cleanups.
+func (c *CacheService) DeleteByPattern(ctx context.Context, pattern string) error {
+	c.local.deleteByPattern(pattern)
+
+	err := c.do(ctx, func() error {
+		iter := c.client.Scan(ctx, 0, pattern, 500).Iterator()
+
+		var keys []string
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+			if len(keys) == 500 {
+				if err := c.client.Del(ctx, keys...).Err(); err != nil {
+					return err
+				}
+				keys = keys[:0]
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			return c.client.Del(ctx, keys...).Err()
+		}
+		return nil
+	})
+	if err != nil {
+		c.queueInvalidation(c.pendingPatterns, pattern)
+		if err != errUnavailable {
+			return fmt.Errorf("redis delete by pattern error: %w", err)
 		}
 	}
 
 	return nil
 }
 
+// queueInvalidation remembers an invalidation Redis missed, otherwise it would
+// serve entries from before the change once it's back.
+func (c *CacheService) queueInvalidation(pending map[string]struct{}, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.pendingKeys)+len(c.pendingTags)+len(c.pendingPatterns) >= maxPendingInvalidations {
+		log.Printf("Too many invalidations pending while Redis is down, dropping %s", value)
+		return
+	}
+	pending[value] = struct{}{}
+}
+
+func (c *CacheService) replayInvalidations() {
+	c.mu.Lock()
+	keys, tags, patterns := c.pendingKeys, c.pendingTags, c.pendingPatterns
+	c.pendingKeys = make(map[string]struct{})
+	c.pendingTags = make(map[string]struct{})
+	c.pendingPatterns = make(map[string]struct{})
+	c.mu.Unlock()
+
+	// Failures queue them again for the next recovery
+	ctx := context.Background()
+	for key := range keys {
+		c.Delete(ctx, key)
+	}
+	for tag := range tags {
+		c.InvalidateTags(ctx, tag)
+	}
+	for pattern := range patterns {
+		c.DeleteByPattern(ctx, pattern)
+	}
+}
+
 // GenerateKey creates a standardized cache key
 func GenerateKey(prefix string, parts ...interface{}) string {
 	key := prefix
@@ -13,21 +13,25 @@ import (
 
 const createAd = `-- name: CreateAd :one
 INSERT INTO "ads"
-("title", "description", "image_url", "target_url", "placement", "status", "start_date", "end_date")
+("title", "description", "image_url", "target_url", "placement", "status", "start_date", "end_date", "weight", "frequency_cap", "impression_goal", "is_house")
 VALUES
-($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 `
 
 type CreateAdParams struct {
-	Title       pgtype.Text
-	Description pgtype.Text
-	ImageUrl    pgtype.Text
-	TargetUrl   pgtype.Text
-	Placement   pgtype.Text
-	Status      pgtype.Text
-	StartDate   pgtype.Timestamptz
-	EndDate     pgtype.Timestamptz
+	Title          pgtype.Text
+	Description    pgtype.Text
+	ImageUrl       pgtype.Text
+	TargetUrl      pgtype.Text
+	Placement      pgtype.Text
+	Status         pgtype.Text
+	StartDate      pgtype.Timestamptz
+	EndDate        pgtype.Timestamptz
+	Weight         int32
+	FrequencyCap   int32
+	ImpressionGoal int32
+	IsHouse        bool
 }
 
 func (q *Queries) CreateAd(ctx context.Context, arg CreateAdParams) (Ad, error) {
@@ -40,6 +44,10 @@ func (q *Queries) CreateAd(ctx context.Context, arg CreateAdParams) (Ad, error)
 		arg.Status,
 		arg.StartDate,
 		arg.EndDate,
+		arg.Weight,
+		arg.FrequencyCap,
+		arg.ImpressionGoal,
+		arg.IsHouse,
 	)
 	var i Ad
 	err := row.Scan(
@@ -55,6 +63,12 @@ func (q *Queries) CreateAd(ctx context.Context, arg CreateAdParams) (Ad, error)
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Weight,
+		&i.FrequencyCap,
+		&i.ImpressionGoal,
+		&i.IsHouse,
+		&i.State,
+		&i.StateChangedAt,
 	)
 	return i, err
 }
@@ -67,7 +81,7 @@ SET
   "start_date" = NULL,
   "end_date" = NULL
 WHERE "id" = $1
-RETURNING id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+RETURNING id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 `
 
 func (q *Queries) DeactivateAd(ctx context.Context, id pgtype.UUID) (Ad, error) {
@@ -86,6 +100,12 @@ func (q *Queries) DeactivateAd(ctx context.Context, id pgtype.UUID) (Ad, error)
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Weight,
+		&i.FrequencyCap,
+		&i.ImpressionGoal,
+		&i.IsHouse,
+		&i.State,
+		&i.StateChangedAt,
 	)
 	return i, err
 }
@@ -100,8 +120,52 @@ func (q *Queries) DeleteAd(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
+const extendAd = `-- name: ExtendAd :one
+UPDATE "ads"
+SET
+  "start_date" = $2,
+  "end_date" = $3,
+  "status" = 'active',
+  "updated_at" = now()
+WHERE "id" = $1
+RETURNING id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
+`
+
+type ExtendAdParams struct {
+	ID        pgtype.UUID
+	StartDate pgtype.Timestamptz
+	EndDate   pgtype.Timestamptz
+}
+
+// Moves a campaign's dates and switches it back on
+func (q *Queries) ExtendAd(ctx context.Context, arg ExtendAdParams) (Ad, error) {
+	row := q.db.QueryRow(ctx, extendAd, arg.ID, arg.StartDate, arg.EndDate)
+	var i Ad
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.ImageUrl,
+		&i.TargetUrl,
+		&i.Placement,
+		&i.Status,
+		&i.Clicks,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Weight,
+		&i.FrequencyCap,
+		&i.ImpressionGoal,
+		&i.IsHouse,
+		&i.State,
+		&i.StateChangedAt,
+	)
+	return i, err
+}
+
 const getAd = `-- name: GetAd :one
-SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 FROM "ads"
 WHERE "id" = $1
 `
@@ -122,6 +186,12 @@ func (q *Queries) GetAd(ctx context.Context, id pgtype.UUID) (Ad, error) {
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Weight,
+		&i.FrequencyCap,
+		&i.ImpressionGoal,
+		&i.IsHouse,
+		&i.State,
+		&i.StateChangedAt,
 	)
 	return i, err
 }
@@ -143,7 +213,7 @@ func (q *Queries) IncrementAdClicks(ctx context.Context, id pgtype.UUID) (pgtype
 }
 
 const listActiveAds = `-- name: ListActiveAds :many
-SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 FROM "ads"
 WHERE "status" = 'active'
   AND "start_date" <= now() AT TIME ZONE 'Europe/Belgrade'
@@ -174,6 +244,12 @@ func (q *Queries) ListActiveAds(ctx context.Context, limit int32) ([]Ad, error)
 			&i.EndDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Weight,
+			&i.FrequencyCap,
+			&i.ImpressionGoal,
+			&i.IsHouse,
+			&i.State,
+			&i.StateChangedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -186,7 +262,7 @@ func (q *Queries) ListActiveAds(ctx context.Context, limit int32) ([]Ad, error)
 }
 
 const listAds = `-- name: ListAds :many
-SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 FROM "ads"
 LIMIT $1
 `
@@ -213,6 +289,12 @@ func (q *Queries) ListAds(ctx context.Context, limit int32) ([]Ad, error) {
 			&i.EndDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Weight,
+			&i.FrequencyCap,
+			&i.ImpressionGoal,
+			&i.IsHouse,
+			&i.State,
+			&i.StateChangedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -225,7 +307,7 @@ func (q *Queries) ListAds(ctx context.Context, limit int32) ([]Ad, error) {
 }
 
 const listAdsByPlacement = `-- name: ListAdsByPlacement :many
-SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 FROM "ads"
 WHERE "placement" = $1
   AND "status" = 'active'
@@ -261,6 +343,59 @@ func (q *Queries) ListAdsByPlacement(ctx context.Context, arg ListAdsByPlacement
 			&i.EndDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Weight,
+			&i.FrequencyCap,
+			&i.ImpressionGoal,
+			&i.IsHouse,
+			&i.State,
+			&i.StateChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArchivedAds = `-- name: ListArchivedAds :many
+SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
+FROM "ads"
+WHERE "state" = 'archived'
+ORDER BY "end_date" DESC
+LIMIT $1
+`
+
+func (q *Queries) ListArchivedAds(ctx context.Context, limit int32) ([]Ad, error) {
+	rows, err := q.db.Query(ctx, listArchivedAds, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Ad
+	for rows.Next() {
+		var i Ad
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.ImageUrl,
+			&i.TargetUrl,
+			&i.Placement,
+			&i.Status,
+			&i.Clicks,
+			&i.StartDate,
+			&i.EndDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Weight,
+			&i.FrequencyCap,
+			&i.ImpressionGoal,
+			&i.IsHouse,
+			&i.State,
+			&i.StateChangedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -273,9 +408,10 @@ func (q *Queries) ListAdsByPlacement(ctx context.Context, arg ListAdsByPlacement
 }
 
 const listInactiveAds = `-- name: ListInactiveAds :many
-SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 FROM "ads"
 WHERE "status" = 'inactive'
+  AND "state" <> 'archived'
 ORDER BY "created_at" DESC
 LIMIT $1
 `
@@ -302,6 +438,12 @@ func (q *Queries) ListInactiveAds(ctx context.Context, limit int32) ([]Ad, error
 			&i.EndDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Weight,
+			&i.FrequencyCap,
+			&i.ImpressionGoal,
+			&i.IsHouse,
+			&i.State,
+			&i.StateChangedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -314,7 +456,7 @@ func (q *Queries) ListInactiveAds(ctx context.Context, limit int32) ([]Ad, error
 }
 
 const listScheduledAds = `-- name: ListScheduledAds :many
-SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+SELECT id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 FROM "ads"
 WHERE "status" = 'active'
   AND "start_date" > now() AT TIME ZONE 'Europe/Belgrade'
@@ -344,6 +486,185 @@ func (q *Queries) ListScheduledAds(ctx context.Context, limit int32) ([]Ad, erro
 			&i.EndDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Weight,
+			&i.FrequencyCap,
+			&i.ImpressionGoal,
+			&i.IsHouse,
+			&i.State,
+			&i.StateChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServableAds = `-- name: ListServableAds :many
+SELECT a.id, a.title, a.description, a.image_url, a.target_url, a.placement, a.status, a.clicks, a.start_date, a.end_date, a.created_at, a.updated_at, a.weight, a.frequency_cap, a.impression_goal, a.is_house, a.state, a.state_changed_at,
+  COALESCE((SELECT SUM(s.impressions) FROM "ad_stat" s WHERE s.ad_id = a.id), 0)::INT AS impressions,
+  COALESCE(t.target_categories, '{}')::UUID[] AS target_categories,
+  COALESCE(t.exclude_categories, '{}')::UUID[] AS exclude_categories,
+  COALESCE(t.target_tags, '{}')::UUID[] AS target_tags,
+  COALESCE(t.exclude_tags, '{}')::UUID[] AS exclude_tags,
+  COALESCE(t.target_page_types, '{}')::TEXT[] AS target_page_types,
+  COALESCE(t.exclude_page_types, '{}')::TEXT[] AS exclude_page_types,
+  COALESCE(t.daypart_start, 0)::INT AS daypart_start,
+  COALESCE(t.daypart_end, 24)::INT AS daypart_end
+FROM "ads" a
+LEFT JOIN "ad_targeting" t ON t.ad_id = a.id
+WHERE a.status = 'active'
+  AND a.start_date <= now()
+  AND a.end_date > now()
+ORDER BY a.created_at DESC
+`
+
+type ListServableAdsRow struct {
+	ID                pgtype.UUID
+	Title             pgtype.Text
+	Description       pgtype.Text
+	ImageUrl          pgtype.Text
+	TargetUrl         pgtype.Text
+	Placement         pgtype.Text
+	Status            pgtype.Text
+	Clicks            pgtype.Int4
+	StartDate         pgtype.Timestamptz
+	EndDate           pgtype.Timestamptz
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	Weight            int32
+	FrequencyCap      int32
+	ImpressionGoal    int32
+	IsHouse           bool
+	State             string
+	StateChangedAt    pgtype.Timestamptz
+	Impressions       int32
+	TargetCategories  []pgtype.UUID
+	ExcludeCategories []pgtype.UUID
+	TargetTags        []pgtype.UUID
+	ExcludeTags       []pgtype.UUID
+	TargetPageTypes   []string
+	ExcludePageTypes  []string
+	DaypartStart      int32
+	DaypartEnd        int32
+}
+
+// Running campaigns and house ads with the impressions they have had so far
+// and where they are targeted. Running is the active and ending_soon states
+// of TransitionAds, by the same clock.
+func (q *Queries) ListServableAds(ctx context.Context) ([]ListServableAdsRow, error) {
+	rows, err := q.db.Query(ctx, listServableAds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListServableAdsRow
+	for rows.Next() {
+		var i ListServableAdsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.ImageUrl,
+			&i.TargetUrl,
+			&i.Placement,
+			&i.Status,
+			&i.Clicks,
+			&i.StartDate,
+			&i.EndDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Weight,
+			&i.FrequencyCap,
+			&i.ImpressionGoal,
+			&i.IsHouse,
+			&i.State,
+			&i.StateChangedAt,
+			&i.Impressions,
+			&i.TargetCategories,
+			&i.ExcludeCategories,
+			&i.TargetTags,
+			&i.ExcludeTags,
+			&i.TargetPageTypes,
+			&i.ExcludePageTypes,
+			&i.DaypartStart,
+			&i.DaypartEnd,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const transitionAds = `-- name: TransitionAds :many
+UPDATE "ads" a
+SET
+  "state" = next.state,
+  "status" = CASE WHEN next.state IN ('expired', 'archived') THEN 'inactive' ELSE a.status END,
+  "state_changed_at" = now(),
+  "updated_at" = now()
+FROM (
+  SELECT "id",
+    CASE
+      WHEN ("start_date" IS NULL OR "end_date" IS NULL)
+        AND ("state" = 'archived' OR ("state" = 'expired' AND "state_changed_at" <= now() - make_interval(days => $1::INT))) THEN 'archived'
+      WHEN "start_date" IS NULL OR "end_date" IS NULL THEN 'expired'
+      WHEN "end_date" <= now() - make_interval(days => $1::INT) THEN 'archived'
+      WHEN "end_date" <= now() THEN 'expired'
+      WHEN "start_date" > now() THEN 'scheduled'
+      WHEN "end_date" <= now() + make_interval(days => $2::INT) THEN 'ending_soon'
+      ELSE 'active'
+    END AS state
+  FROM "ads"
+) next
+WHERE a.id = next.id
+  AND a.state <> next.state
+RETURNING a.id, a.title, a.description, a.image_url, a.target_url, a.placement, a.status, a.clicks, a.start_date, a.end_date, a.created_at, a.updated_at, a.weight, a.frequency_cap, a.impression_goal, a.is_house, a.state, a.state_changed_at
+`
+
+type TransitionAdsParams struct {
+	ArchiveAfterDays int32
+	WarningDays      int32
+}
+
+// Moves every ad to the state its dates put it in, switching expired ones
+// off, and returns the ads that changed state. Ads switched off by hand have
+// no dates, they are archived archive_after_days after they expired.
+func (q *Queries) TransitionAds(ctx context.Context, arg TransitionAdsParams) ([]Ad, error) {
+	rows, err := q.db.Query(ctx, transitionAds, arg.ArchiveAfterDays, arg.WarningDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Ad
+	for rows.Next() {
+		var i Ad
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.ImageUrl,
+			&i.TargetUrl,
+			&i.Placement,
+			&i.Status,
+			&i.Clicks,
+			&i.StartDate,
+			&i.EndDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Weight,
+			&i.FrequencyCap,
+			&i.ImpressionGoal,
+			&i.IsHouse,
+			&i.State,
+			&i.StateChangedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -366,21 +687,29 @@ SET
   "status" = $6,
   "start_date" = $7,
   "end_date" = $8,
+  "weight" = $9,
+  "frequency_cap" = $10,
+  "impression_goal" = $11,
+  "is_house" = $12,
   "updated_at" = now()
-WHERE "id" = $9
-RETURNING id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at
+WHERE "id" = $13
+RETURNING id, title, description, image_url, target_url, placement, status, clicks, start_date, end_date, created_at, updated_at, weight, frequency_cap, impression_goal, is_house, state, state_changed_at
 `
 
 type UpdateAdParams struct {
-	Title       pgtype.Text
-	Description pgtype.Text
-	ImageUrl    pgtype.Text
-	TargetUrl   pgtype.Text
-	Placement   pgtype.Text
-	Status      pgtype.Text
-	StartDate   pgtype.Timestamptz
-	EndDate     pgtype.Timestamptz
-	ID          pgtype.UUID
+	Title          pgtype.Text
+	Description    pgtype.Text
+	ImageUrl       pgtype.Text
+	TargetUrl      pgtype.Text
+	Placement      pgtype.Text
+	Status         pgtype.Text
+	StartDate      pgtype.Timestamptz
+	EndDate        pgtype.Timestamptz
+	Weight         int32
+	FrequencyCap   int32
+	ImpressionGoal int32
+	IsHouse        bool
+	ID             pgtype.UUID
 }
 
 func (q *Queries) UpdateAd(ctx context.Context, arg UpdateAdParams) (Ad, error) {
@@ -393,6 +722,10 @@ func (q *Queries) UpdateAd(ctx context.Context, arg UpdateAdParams) (Ad, error)
 		arg.Status,
 		arg.StartDate,
 		arg.EndDate,
+		arg.Weight,
+		arg.FrequencyCap,
+		arg.ImpressionGoal,
+		arg.IsHouse,
 		arg.ID,
 	)
 	var i Ad
@@ -409,6 +742,12 @@ func (q *Queries) UpdateAd(ctx context.Context, arg UpdateAdParams) (Ad, error)
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Weight,
+		&i.FrequencyCap,
+		&i.ImpressionGoal,
+		&i.IsHouse,
+		&i.State,
+		&i.StateChangedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdAdvertiser(t *testing.T) {
+	ad := createRandomAd(t)
+	user := createRandomUser(t)
+
+	arg := AddAdAdvertiserParams{AdID: ad.ID, UserID: user.UserID}
+
+	// Linking twice keeps a single link
+	for i := 0; i < 2; i++ {
+		err := testQueries.AddAdAdvertiser(context.Background(), arg)
+		require.NoError(t, err)
+	}
+
+	advertisers, err := testQueries.ListAdAdvertisers(context.Background(), ad.ID)
+	require.NoError(t, err)
+	require.Len(t, advertisers, 1)
+	require.Equal(t, user.UserID, advertisers[0].UserID)
+	require.Equal(t, user.Email, advertisers[0].Email)
+
+	count, err := testQueries.CountAdvertiserAds(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	err = testQueries.RemoveAdAdvertiser(context.Background(), RemoveAdAdvertiserParams{AdID: ad.ID, UserID: user.UserID})
+	require.NoError(t, err)
+
+	count, err = testQueries.CountAdvertiserAds(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestListAdvertiserAds(t *testing.T) {
+	ad := createRandomAd(t)
+	other := createRandomAd(t)
+	user := createRandomUser(t)
+
+	err := testQueries.AddAdAdvertiser(context.Background(), AddAdAdvertiserParams{AdID: ad.ID, UserID: user.UserID})
+	require.NoError(t, err)
+
+	day := pgtype.Date{Time: time.Now().UTC().Truncate(24 * time.Hour), Valid: true}
+	for _, a := range []Ad{ad, other} {
+		err := testQueries.RecordAdImpression(context.Background(), RecordAdImpressionParams{
+			AdID:      a.ID,
+			Placement: a.Placement.String,
+			StatDate:  day,
+		})
+		require.NoError(t, err)
+
+		err = testQueries.RecordAdClick(context.Background(), RecordAdClickParams{
+			AdID:      a.ID,
+			Placement: a.Placement.String,
+			StatDate:  day,
+		})
+		require.NoError(t, err)
+	}
+
+	ads, err := testQueries.ListAdvertiserAds(context.Background(), ListAdvertiserAdsParams{
+		StartDate: day,
+		EndDate:   day,
+		UserID:    user.UserID,
+	})
+	require.NoError(t, err)
+	require.Len(t, ads, 1)
+	require.Equal(t, ad.ID, ads[0].ID)
+	require.Equal(t, int32(1), ads[0].Impressions)
+	require.Equal(t, int32(1), ads[0].Clicks)
+
+	// Ads without stats in the period are listed with zeros
+	yesterday := pgtype.Date{Time: day.Time.AddDate(0, 0, -1), Valid: true}
+	ads, err = testQueries.ListAdvertiserAds(context.Background(), ListAdvertiserAdsParams{
+		StartDate: yesterday,
+		EndDate:   yesterday,
+		UserID:    user.UserID,
+	})
+	require.NoError(t, err)
+	require.Len(t, ads, 1)
+	require.Zero(t, ads[0].Impressions)
+
+	stats, err := testQueries.ListAdvertiserDailyStats(context.Background(), ListAdvertiserDailyStatsParams{
+		UserID:    user.UserID,
+		StartDate: day,
+		EndDate:   day,
+	})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, ad.ID, stats[0].ID)
+	require.Equal(t, int32(1), stats[0].Clicks)
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAdPlacement(t *testing.T) AdPlacement {
+	arg := CreateAdPlacementParams{
+		Key:       "test-" + utils.RandomString(8),
+		Name:      utils.RandomString(8),
+		Width:     728,
+		Height:    90,
+		Formats:   []string{"image"},
+		PageTypes: []string{"home", "article"},
+		IsActive:  true,
+	}
+
+	placement, err := testQueries.CreateAdPlacement(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Key, placement.Key)
+	require.Equal(t, arg.Name, placement.Name)
+	require.Equal(t, arg.Width, placement.Width)
+	require.Equal(t, arg.Height, placement.Height)
+	require.Equal(t, arg.Formats, placement.Formats)
+	require.Equal(t, arg.PageTypes, placement.PageTypes)
+	require.True(t, placement.IsActive)
+
+	return placement
+}
+
+func TestCreateAdPlacement(t *testing.T) {
+	createRandomAdPlacement(t)
+}
+
+func TestUpdateAdPlacement(t *testing.T) {
+	placement := createRandomAdPlacement(t)
+
+	arg := UpdateAdPlacementParams{
+		Name:      utils.RandomString(8),
+		Width:     300,
+		Height:    600,
+		Formats:   []string{"image", "video"},
+		PageTypes: []string{"category"},
+		IsActive:  false,
+		Key:       placement.Key,
+	}
+
+	updated, err := testQueries.UpdateAdPlacement(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, placement.Key, updated.Key)
+	require.Equal(t, arg.Name, updated.Name)
+	require.Equal(t, arg.Width, updated.Width)
+	require.Equal(t, arg.Height, updated.Height)
+	require.Equal(t, arg.Formats, updated.Formats)
+	require.Equal(t, arg.PageTypes, updated.PageTypes)
+	require.False(t, updated.IsActive)
+}
+
+func TestListAdPlacements(t *testing.T) {
+	placement := createRandomAdPlacement(t)
+
+	placements, err := testQueries.ListAdPlacements(context.Background())
+	require.NoError(t, err)
+
+	var found bool
+	for _, p := range placements {
+		if p.Key == placement.Key {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestDeleteAdPlacement(t *testing.T) {
+	placement := createRandomAdPlacement(t)
+
+	ad, err := testQueries.CreateAd(context.Background(), CreateAdParams{
+		Title:     pgtype.Text{String: utils.RandomString(8), Valid: true},
+		Placement: pgtype.Text{String: placement.Key, Valid: true},
+		Status:    pgtype.Text{String: "inactive", Valid: true},
+		Weight:    1,
+	})
+	require.NoError(t, err)
+
+	count, err := testQueries.CountAdsInPlacement(context.Background(), ad.Placement)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// Placements with ads can't be deleted
+	err = testQueries.DeleteAdPlacement(context.Background(), placement.Key)
+	require.Error(t, err)
+
+	err = testQueries.DeleteAd(context.Background(), ad.ID)
+	require.NoError(t, err)
+
+	err = testQueries.DeleteAdPlacement(context.Background(), placement.Key)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetAdPlacement(context.Background(), placement.Key)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdStats(t *testing.T) {
+	ad := createRandomAd(t)
+	// A day in the past no other test records stats for
+	day := pgtype.Date{Time: time.Date(1990, time.January, int(utils.RandomInt(1, 28)), 0, 0, 0, 0, time.UTC), Valid: true}
+
+	for i := 0; i < 2; i++ {
+		err := testQueries.RecordAdImpression(context.Background(), RecordAdImpressionParams{
+			AdID:      ad.ID,
+			Placement: ad.Placement.String,
+			StatDate:  day,
+		})
+		require.NoError(t, err)
+	}
+
+	err := testQueries.RecordAdClick(context.Background(), RecordAdClickParams{
+		AdID:      ad.ID,
+		Placement: ad.Placement.String,
+		StatDate:  day,
+	})
+	require.NoError(t, err)
+
+	stats, err := testQueries.ListAdStats(context.Background(), ListAdStatsParams{
+		StartDate: day,
+		EndDate:   day,
+	})
+	require.NoError(t, err)
+
+	var found bool
+	for _, stat := range stats {
+		if stat.ID == ad.ID {
+			found = true
+			require.Equal(t, ad.Placement.String, stat.Placement)
+			require.Equal(t, int32(2), stat.Impressions)
+			require.Equal(t, int32(1), stat.Clicks)
+		}
+	}
+	require.True(t, found)
+
+	daily, err := testQueries.ListAdDailyStats(context.Background(), ListAdDailyStatsParams{
+		StartDate: day,
+		EndDate:   day,
+	})
+	require.NoError(t, err)
+
+	found = false
+	for _, stat := range daily {
+		if stat.ID == ad.ID {
+			found = true
+			require.Equal(t, day.Time, stat.StatDate.Time)
+			require.Equal(t, int32(2), stat.Impressions)
+			require.Equal(t, int32(1), stat.Clicks)
+		}
+	}
+	require.True(t, found)
+
+	err = testQueries.DeleteAd(context.Background(), ad.ID)
+	require.NoError(t, err)
+
+	stats, err = testQueries.ListAdStats(context.Background(), ListAdStatsParams{
+		StartDate: day,
+		EndDate:   day,
+	})
+	require.NoError(t, err)
+	for _, stat := range stats {
+		require.NotEqual(t, ad.ID, stat.ID)
+	}
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdTargeting(t *testing.T) {
+	ad := createRandomAd(t)
+	tag := createRandomTag(t)
+	content := createRandomContent(t)
+
+	_, err := testQueries.GetAdTargeting(context.Background(), ad.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	arg := UpsertAdTargetingParams{
+		AdID:              ad.ID,
+		TargetCategories:  []pgtype.UUID{content.CategoryID},
+		ExcludeCategories: []pgtype.UUID{},
+		TargetTags:        []pgtype.UUID{tag.TagID},
+		ExcludeTags:       []pgtype.UUID{},
+		TargetPageTypes:   []string{"article"},
+		ExcludePageTypes:  []string{"home"},
+		DaypartStart:      22,
+		DaypartEnd:        6,
+	}
+
+	err = testQueries.UpsertAdTargeting(context.Background(), arg)
+	require.NoError(t, err)
+
+	targeting, err := testQueries.GetAdTargeting(context.Background(), ad.ID)
+	require.NoError(t, err)
+	require.Equal(t, arg.TargetCategories, targeting.TargetCategories)
+	require.Equal(t, arg.TargetTags, targeting.TargetTags)
+	require.Equal(t, arg.TargetPageTypes, targeting.TargetPageTypes)
+	require.Equal(t, arg.ExcludePageTypes, targeting.ExcludePageTypes)
+	require.Equal(t, arg.DaypartStart, targeting.DaypartStart)
+	require.Equal(t, arg.DaypartEnd, targeting.DaypartEnd)
+
+	// Saving again replaces the rules
+	arg.TargetTags = []pgtype.UUID{}
+	arg.DaypartStart = 0
+	arg.DaypartEnd = 24
+
+	err = testQueries.UpsertAdTargeting(context.Background(), arg)
+	require.NoError(t, err)
+
+	targeting, err = testQueries.GetAdTargeting(context.Background(), ad.ID)
+	require.NoError(t, err)
+	require.Empty(t, targeting.TargetTags)
+	require.Equal(t, int32(24), targeting.DaypartEnd)
+
+	_, err = testQueries.UpdateAd(context.Background(), UpdateAdParams{
+		Title:       ad.Title,
+		Description: ad.Description,
+		ImageUrl:    ad.ImageUrl,
+		TargetUrl:   ad.TargetUrl,
+		Placement:   ad.Placement,
+		Status:      pgtype.Text{String: "active", Valid: true},
+		StartDate:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		EndDate:     pgtype.Timestamptz{Time: time.Now().Add(time.Hour * 24), Valid: true},
+		Weight:      1,
+		ID:          ad.ID,
+	})
+	require.NoError(t, err)
+
+	ads, err := testQueries.ListServableAds(context.Background())
+	require.NoError(t, err)
+
+	var found bool
+	for _, servable := range ads {
+		if servable.ID == ad.ID {
+			found = true
+			require.Equal(t, arg.TargetCategories, servable.TargetCategories)
+			require.Equal(t, arg.ExcludePageTypes, servable.ExcludePageTypes)
+		}
+	}
+	require.True(t, found)
+
+	// Targeting goes with the ad
+	err = testQueries.DeleteAd(context.Background(), ad.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetAdTargeting(context.Background(), ad.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
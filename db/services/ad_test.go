@@ -26,6 +26,7 @@ func createRandomAd(t *testing.T) Ad {
 		EndDate: pgtype.Timestamptz{
 			Valid: false,
 		},
+		Weight: 1,
 	}
 
 	ad, err := testQueries.CreateAd(context.Background(), arg)
@@ -88,7 +89,11 @@ func TestUpdateAd(t *testing.T) {
 			Time:  time.Now().Add(time.Hour * 24),
 			Valid: true,
 		},
-		ID: ad.ID,
+		Weight:         5,
+		FrequencyCap:   3,
+		ImpressionGoal: 1000,
+		IsHouse:        true,
+		ID:             ad.ID,
 	}
 
 	updatedAd, err := testQueries.UpdateAd(context.Background(), arg)
@@ -103,6 +108,10 @@ func TestUpdateAd(t *testing.T) {
 	require.Equal(t, arg.Status.String, updatedAd.Status.String)
 	require.WithinDuration(t, arg.StartDate.Time, updatedAd.StartDate.Time, time.Second)
 	require.WithinDuration(t, arg.EndDate.Time, updatedAd.EndDate.Time, time.Second)
+	require.Equal(t, arg.Weight, updatedAd.Weight)
+	require.Equal(t, arg.FrequencyCap, updatedAd.FrequencyCap)
+	require.Equal(t, arg.ImpressionGoal, updatedAd.ImpressionGoal)
+	require.True(t, updatedAd.IsHouse)
 }
 
 func TestDeactivateAd(t *testing.T) {
@@ -129,7 +138,8 @@ func TestDeactivateAd(t *testing.T) {
 			Time:  time.Now().Add(time.Hour * 24),
 			Valid: true,
 		},
-		ID: ad.ID,
+		Weight: 1,
+		ID:     ad.ID,
 	}
 
 	updatedAd, err := testQueries.UpdateAd(context.Background(), arg)
@@ -235,7 +245,8 @@ func TestListActiveAds(t *testing.T) {
 			Time:  time.Now().Add(time.Hour * 24),
 			Valid: true,
 		},
-		ID: ad.ID,
+		Weight: 1,
+		ID:     ad.ID,
 	}
 
 	updatedAd, err := testQueries.UpdateAd(context.Background(), arg)
@@ -281,7 +292,8 @@ func TestListAdsByPlacement(t *testing.T) {
 			Time:  time.Now().Add(time.Hour * 24),
 			Valid: true,
 		},
-		ID: ad.ID,
+		Weight: 1,
+		ID:     ad.ID,
 	}
 
 	updatedAd, err := testQueries.UpdateAd(context.Background(), arg)
@@ -308,3 +320,163 @@ func TestIncrementAdClicks(t *testing.T) {
 	require.NotZero(t, clicks.Int32)
 	require.Equal(t, ad.Clicks.Int32+1, clicks.Int32)
 }
+
+func TestListServableAds(t *testing.T) {
+	ad := createRandomAd(t)
+
+	arg := UpdateAdParams{
+		Title:       ad.Title,
+		Description: ad.Description,
+		ImageUrl:    ad.ImageUrl,
+		TargetUrl:   ad.TargetUrl,
+		Placement:   ad.Placement,
+		Status:      pgtype.Text{String: "active", Valid: true},
+		StartDate: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		EndDate: pgtype.Timestamptz{
+			Time:  time.Now().Add(time.Hour * 24),
+			Valid: true,
+		},
+		Weight:         10,
+		FrequencyCap:   2,
+		ImpressionGoal: 500,
+		ID:             ad.ID,
+	}
+
+	_, err := testQueries.UpdateAd(context.Background(), arg)
+	require.NoError(t, err)
+
+	err = testQueries.RecordAdImpression(context.Background(), RecordAdImpressionParams{
+		AdID:      ad.ID,
+		Placement: ad.Placement.String,
+		StatDate:  pgtype.Date{Time: time.Now(), Valid: true},
+	})
+	require.NoError(t, err)
+
+	ads, err := testQueries.ListServableAds(context.Background())
+	require.NoError(t, err)
+
+	var found bool
+	for _, servable := range ads {
+		if servable.ID == ad.ID {
+			found = true
+			require.Equal(t, arg.Weight, servable.Weight)
+			require.Equal(t, arg.FrequencyCap, servable.FrequencyCap)
+			require.Equal(t, arg.ImpressionGoal, servable.ImpressionGoal)
+			require.False(t, servable.IsHouse)
+			require.Equal(t, int32(1), servable.Impressions)
+			// Ads without targeting are served everywhere, all day
+			require.Empty(t, servable.TargetCategories)
+			require.Empty(t, servable.ExcludePageTypes)
+			require.Equal(t, int32(0), servable.DaypartStart)
+			require.Equal(t, int32(24), servable.DaypartEnd)
+		}
+	}
+	require.True(t, found)
+
+	_, err = testQueries.DeactivateAd(context.Background(), ad.ID)
+	require.NoError(t, err)
+
+	ads, err = testQueries.ListServableAds(context.Background())
+	require.NoError(t, err)
+	for _, servable := range ads {
+		require.NotEqual(t, ad.ID, servable.ID)
+	}
+}
+
+func TestTransitionAds(t *testing.T) {
+	ad := createRandomAd(t)
+	now := time.Now()
+
+	transition := func() Ad {
+		_, err := testQueries.TransitionAds(context.Background(), TransitionAdsParams{
+			ArchiveAfterDays: 30,
+			WarningDays:      7,
+		})
+		require.NoError(t, err)
+
+		ad, err := testQueries.GetAd(context.Background(), ad.ID)
+		require.NoError(t, err)
+		return ad
+	}
+
+	extended, err := testQueries.ExtendAd(context.Background(), ExtendAdParams{
+		ID:        ad.ID,
+		StartDate: pgtype.Timestamptz{Time: now.AddDate(0, 0, -1), Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: now.AddDate(0, 0, 3), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "active", extended.Status.String)
+
+	ad = transition()
+	require.Equal(t, "ending_soon", ad.State)
+	require.Equal(t, "active", ad.Status.String)
+	require.True(t, ad.StateChangedAt.Valid)
+
+	_, err = testQueries.ExtendAd(context.Background(), ExtendAdParams{
+		ID:        ad.ID,
+		StartDate: pgtype.Timestamptz{Time: now.AddDate(0, 0, -1), Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: now.AddDate(0, 0, 30), Valid: true},
+	})
+	require.NoError(t, err)
+
+	ad = transition()
+	require.Equal(t, "active", ad.State)
+
+	_, err = testQueries.ExtendAd(context.Background(), ExtendAdParams{
+		ID:        ad.ID,
+		StartDate: pgtype.Timestamptz{Time: now.AddDate(0, 0, -60), Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: now.AddDate(0, 0, -40), Valid: true},
+	})
+	require.NoError(t, err)
+
+	ad = transition()
+	require.Equal(t, "archived", ad.State)
+	require.Equal(t, "inactive", ad.Status.String)
+
+	archived, err := testQueries.ListArchivedAds(context.Background(), 1000)
+	require.NoError(t, err)
+
+	var found bool
+	for _, archivedAd := range archived {
+		if archivedAd.ID == ad.ID {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	inactive, err := testQueries.ListInactiveAds(context.Background(), 1000)
+	require.NoError(t, err)
+	for _, inactiveAd := range inactive {
+		require.NotEqual(t, ad.ID, inactiveAd.ID)
+	}
+}
+
+func TestTransitionAdsWithoutDates(t *testing.T) {
+	ad := createRandomAd(t)
+
+	transition := func(archiveAfterDays int32) Ad {
+		_, err := testQueries.TransitionAds(context.Background(), TransitionAdsParams{
+			ArchiveAfterDays: archiveAfterDays,
+			WarningDays:      7,
+		})
+		require.NoError(t, err)
+
+		ad, err := testQueries.GetAd(context.Background(), ad.ID)
+		require.NoError(t, err)
+		return ad
+	}
+
+	_, err := testQueries.DeactivateAd(context.Background(), ad.ID)
+	require.NoError(t, err)
+
+	// Switched off by hand, the ad expires and waits out the archive period
+	require.Equal(t, "expired", transition(30).State)
+	require.Equal(t, "expired", transition(30).State)
+
+	// Archived from when it expired, and stays archived
+	require.Equal(t, "archived", transition(0).State)
+	require.Equal(t, "archived", transition(30).State)
+}
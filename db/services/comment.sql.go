@@ -11,20 +11,96 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const approveComment = `-- name: ApproveComment :one
+UPDATE comment
+SET
+  is_held = false,
+  held_reason = ''
+WHERE comment_id = $1
+  AND is_held = true
+RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id, is_held, held_reason, is_shadowed
+`
+
+func (q *Queries) ApproveComment(ctx context.Context, commentID pgtype.UUID) (Comment, error) {
+	row := q.db.QueryRow(ctx, approveComment, commentID)
+	var i Comment
+	err := row.Scan(
+		&i.CommentID,
+		&i.ContentID,
+		&i.UserID,
+		&i.CommentText,
+		&i.Score,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.IsDeleted,
+		&i.ParentCommentID,
+		&i.IsHeld,
+		&i.HeldReason,
+		&i.IsShadowed,
+	)
+	return i, err
+}
+
+const countDuplicateComments = `-- name: CountDuplicateComments :one
+SELECT COUNT(*)
+FROM comment
+WHERE md5(lower(comment_text)) = md5(lower($1::text))
+  AND content_id <> $2
+  AND created_at > now() - interval '1 day'
+  AND is_deleted = false
+`
+
+type CountDuplicateCommentsParams struct {
+	CommentText string
+	ContentID   pgtype.UUID
+}
+
+// Copies of the text posted on other articles during the last day
+func (q *Queries) CountDuplicateComments(ctx context.Context, arg CountDuplicateCommentsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countDuplicateComments, arg.CommentText, arg.ContentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countHeldComments = `-- name: CountHeldComments :one
+SELECT COUNT(*)
+FROM comment
+WHERE is_held = true
+  AND is_deleted = false
+`
+
+func (q *Queries) CountHeldComments(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countHeldComments)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createComment = `-- name: CreateComment :one
-INSERT INTO comment (content_id, user_id, comment_text)
-VALUES ($1, $2, $3)
-RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id
+INSERT INTO comment (content_id, user_id, comment_text, is_held, held_reason, is_shadowed)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id, is_held, held_reason, is_shadowed
 `
 
 type CreateCommentParams struct {
 	ContentID   pgtype.UUID
 	UserID      pgtype.UUID
 	CommentText string
+	IsHeld      bool
+	HeldReason  string
+	IsShadowed  bool
 }
 
 func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error) {
-	row := q.db.QueryRow(ctx, createComment, arg.ContentID, arg.UserID, arg.CommentText)
+	row := q.db.QueryRow(ctx, createComment,
+		arg.ContentID,
+		arg.UserID,
+		arg.CommentText,
+		arg.IsHeld,
+		arg.HeldReason,
+		arg.IsShadowed,
+	)
 	var i Comment
 	err := row.Scan(
 		&i.CommentID,
@@ -36,14 +112,17 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		&i.UpdatedAt,
 		&i.IsDeleted,
 		&i.ParentCommentID,
+		&i.IsHeld,
+		&i.HeldReason,
+		&i.IsShadowed,
 	)
 	return i, err
 }
 
 const createReply = `-- name: CreateReply :one
-INSERT INTO comment (content_id, user_id, comment_text, parent_comment_id)
-VALUES ($1, $2, $3, $4)
-RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id
+INSERT INTO comment (content_id, user_id, comment_text, parent_comment_id, is_held, held_reason, is_shadowed)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id, is_held, held_reason, is_shadowed
 `
 
 type CreateReplyParams struct {
@@ -51,6 +130,9 @@ type CreateReplyParams struct {
 	UserID          pgtype.UUID
 	CommentText     string
 	ParentCommentID pgtype.UUID
+	IsHeld          bool
+	HeldReason      string
+	IsShadowed      bool
 }
 
 func (q *Queries) CreateReply(ctx context.Context, arg CreateReplyParams) (Comment, error) {
@@ -59,6 +141,9 @@ func (q *Queries) CreateReply(ctx context.Context, arg CreateReplyParams) (Comme
 		arg.UserID,
 		arg.CommentText,
 		arg.ParentCommentID,
+		arg.IsHeld,
+		arg.HeldReason,
+		arg.IsShadowed,
 	)
 	var i Comment
 	err := row.Scan(
@@ -71,6 +156,9 @@ func (q *Queries) CreateReply(ctx context.Context, arg CreateReplyParams) (Comme
 		&i.UpdatedAt,
 		&i.IsDeleted,
 		&i.ParentCommentID,
+		&i.IsHeld,
+		&i.HeldReason,
+		&i.IsShadowed,
 	)
 	return i, err
 }
@@ -78,7 +166,7 @@ func (q *Queries) CreateReply(ctx context.Context, arg CreateReplyParams) (Comme
 const deleteComment = `-- name: DeleteComment :one
 DELETE FROM comment
 WHERE comment_id = $1
-RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id
+RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id, is_held, held_reason, is_shadowed
 `
 
 func (q *Queries) DeleteComment(ctx context.Context, commentID pgtype.UUID) (Comment, error) {
@@ -94,6 +182,9 @@ func (q *Queries) DeleteComment(ctx context.Context, commentID pgtype.UUID) (Com
 		&i.UpdatedAt,
 		&i.IsDeleted,
 		&i.ParentCommentID,
+		&i.IsHeld,
+		&i.HeldReason,
+		&i.IsShadowed,
 	)
 	return i, err
 }
@@ -164,7 +255,7 @@ func (q *Queries) FetchCommentReactions(ctx context.Context, arg FetchCommentRea
 }
 
 const getCommentByID = `-- name: GetCommentByID :one
-SELECT comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id FROM comment
+SELECT comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id, is_held, held_reason, is_shadowed FROM comment
 WHERE comment_id = $1
 `
 
@@ -181,6 +272,9 @@ func (q *Queries) GetCommentByID(ctx context.Context, commentID pgtype.UUID) (Co
 		&i.UpdatedAt,
 		&i.IsDeleted,
 		&i.ParentCommentID,
+		&i.IsHeld,
+		&i.HeldReason,
+		&i.IsShadowed,
 	)
 	return i, err
 }
@@ -189,6 +283,8 @@ const getCommentCountForContent = `-- name: GetCommentCountForContent :one
 SELECT count(*) FROM comment
 WHERE content_id = $1
   AND is_deleted = false
+  AND is_held = false
+  AND is_shadowed = false
 `
 
 func (q *Queries) GetCommentCountForContent(ctx context.Context, contentID pgtype.UUID) (int64, error) {
@@ -201,7 +297,7 @@ func (q *Queries) GetCommentCountForContent(ctx context.Context, contentID pgtyp
 const getReplyCount = `-- name: GetReplyCount :one
 SELECT COUNT(*) 
 FROM comment
-WHERE parent_comment_id = $1 AND is_deleted = false
+WHERE parent_comment_id = $1 AND is_deleted = false AND is_held = false AND is_shadowed = false
 `
 
 func (q *Queries) GetReplyCount(ctx context.Context, parentCommentID pgtype.UUID) (int64, error) {
@@ -298,17 +394,69 @@ func (q *Queries) InsertOrUpdateCommentReaction(ctx context.Context, arg InsertO
 	return comment_id, err
 }
 
+const listCommentAncestors = `-- name: ListCommentAncestors :many
+WITH RECURSIVE ancestors AS (
+  SELECT comment_id, parent_comment_id, content_id, 0 AS distance
+  FROM comment
+  WHERE comment_id = $1
+  UNION ALL
+  SELECT c.comment_id, c.parent_comment_id, c.content_id, a.distance + 1
+  FROM comment c
+  JOIN ancestors a ON c.comment_id = a.parent_comment_id
+)
+SELECT comment_id, parent_comment_id, content_id, distance::int AS distance
+FROM ancestors
+ORDER BY distance DESC
+`
+
+type ListCommentAncestorsRow struct {
+	CommentID       pgtype.UUID
+	ParentCommentID pgtype.UUID
+	ContentID       pgtype.UUID
+	Distance        int32
+}
+
+// The comment and the comments above it, the top-level comment first
+func (q *Queries) ListCommentAncestors(ctx context.Context, commentID pgtype.UUID) ([]ListCommentAncestorsRow, error) {
+	rows, err := q.db.Query(ctx, listCommentAncestors, commentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommentAncestorsRow
+	for rows.Next() {
+		var i ListCommentAncestorsRow
+		if err := rows.Scan(
+			&i.CommentID,
+			&i.ParentCommentID,
+			&i.ContentID,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCommentReplies = `-- name: ListCommentReplies :many
-SELECT cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id, u.username, u.pfp, u.role 
+SELECT cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id, cm.is_held, cm.held_reason, cm.is_shadowed, u.username, u.pfp, u.role 
 FROM comment cm 
 JOIN "user" u ON cm.user_id = u.user_id
-WHERE cm.parent_comment_id = $1 AND cm.is_deleted = false
-ORDER BY cm.created_at ASC
-LIMIT $2
+WHERE cm.parent_comment_id = $1 AND cm.is_deleted = false AND cm.is_held = false AND cm.is_shadowed = false
+  AND ($2::timestamptz IS NULL
+    OR (cm.created_at, cm.comment_id) > ($2::timestamptz, $3::uuid))
+ORDER BY cm.created_at ASC, cm.comment_id ASC
+LIMIT $4
 `
 
 type ListCommentRepliesParams struct {
 	ParentCommentID pgtype.UUID
+	AfterCreatedAt  pgtype.Timestamptz
+	AfterCommentID  pgtype.UUID
 	Limit           int32
 }
 
@@ -322,13 +470,21 @@ type ListCommentRepliesRow struct {
 	UpdatedAt       pgtype.Timestamptz
 	IsDeleted       pgtype.Bool
 	ParentCommentID pgtype.UUID
+	IsHeld          bool
+	HeldReason      string
+	IsShadowed      bool
 	Username        string
 	Pfp             string
 	Role            string
 }
 
 func (q *Queries) ListCommentReplies(ctx context.Context, arg ListCommentRepliesParams) ([]ListCommentRepliesRow, error) {
-	rows, err := q.db.Query(ctx, listCommentReplies, arg.ParentCommentID, arg.Limit)
+	rows, err := q.db.Query(ctx, listCommentReplies,
+		arg.ParentCommentID,
+		arg.AfterCreatedAt,
+		arg.AfterCommentID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -346,9 +502,134 @@ func (q *Queries) ListCommentReplies(ctx context.Context, arg ListCommentReplies
 			&i.UpdatedAt,
 			&i.IsDeleted,
 			&i.ParentCommentID,
+			&i.IsHeld,
+			&i.HeldReason,
+			&i.IsShadowed,
+			&i.Username,
+			&i.Pfp,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentRevisions = `-- name: ListCommentRevisions :many
+SELECT revision_id, comment_id, comment_text, created_at FROM comment_revision
+WHERE comment_id = $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListCommentRevisions(ctx context.Context, commentID pgtype.UUID) ([]CommentRevision, error) {
+	rows, err := q.db.Query(ctx, listCommentRevisions, commentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommentRevision
+	for rows.Next() {
+		var i CommentRevision
+		if err := rows.Scan(
+			&i.RevisionID,
+			&i.CommentID,
+			&i.CommentText,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentThread = `-- name: ListCommentThread :many
+WITH RECURSIVE thread AS (
+  SELECT comment_id, 0 AS depth,
+    ARRAY[to_char(created_at AT TIME ZONE 'UTC', 'YYYYMMDDHH24MISSUS') || comment_id::text] AS path
+  FROM comment
+  WHERE comment_id = $1 AND is_deleted = false AND is_held = false AND is_shadowed = false
+  UNION ALL
+  SELECT c.comment_id, t.depth + 1,
+    t.path || (to_char(c.created_at AT TIME ZONE 'UTC', 'YYYYMMDDHH24MISSUS') || c.comment_id::text)
+  FROM comment c
+  JOIN thread t ON c.parent_comment_id = t.comment_id
+  WHERE c.is_deleted = false AND c.is_held = false AND c.is_shadowed = false
+)
+SELECT
+  cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id, cm.is_held, cm.held_reason, cm.is_shadowed,
+  u.username,
+  u.pfp,
+  u.role,
+  t.depth::int AS depth,
+  (SELECT COUNT(*) FROM comment r WHERE r.parent_comment_id = cm.comment_id AND r.is_deleted = false AND r.is_held = false AND r.is_shadowed = false) AS reply_count
+FROM thread t
+JOIN comment cm ON cm.comment_id = t.comment_id
+JOIN "user" u ON cm.user_id = u.user_id
+ORDER BY t.path
+LIMIT $2
+`
+
+type ListCommentThreadParams struct {
+	RootCommentID pgtype.UUID
+	Limit         int32
+}
+
+type ListCommentThreadRow struct {
+	CommentID       pgtype.UUID
+	ContentID       pgtype.UUID
+	UserID          pgtype.UUID
+	CommentText     string
+	Score           int32
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	IsDeleted       pgtype.Bool
+	ParentCommentID pgtype.UUID
+	IsHeld          bool
+	HeldReason      string
+	IsShadowed      bool
+	Username        string
+	Pfp             string
+	Role            string
+	Depth           int32
+	ReplyCount      int64
+}
+
+// A comment and all replies below it, each reply right after the one it answers
+func (q *Queries) ListCommentThread(ctx context.Context, arg ListCommentThreadParams) ([]ListCommentThreadRow, error) {
+	rows, err := q.db.Query(ctx, listCommentThread, arg.RootCommentID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommentThreadRow
+	for rows.Next() {
+		var i ListCommentThreadRow
+		if err := rows.Scan(
+			&i.CommentID,
+			&i.ContentID,
+			&i.UserID,
+			&i.CommentText,
+			&i.Score,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.IsDeleted,
+			&i.ParentCommentID,
+			&i.IsHeld,
+			&i.HeldReason,
+			&i.IsShadowed,
 			&i.Username,
 			&i.Pfp,
 			&i.Role,
+			&i.Depth,
+			&i.ReplyCount,
 		); err != nil {
 			return nil, err
 		}
@@ -362,7 +643,7 @@ func (q *Queries) ListCommentReplies(ctx context.Context, arg ListCommentReplies
 
 const listContentComments = `-- name: ListContentComments :many
 SELECT
-  cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id,
+  cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id, cm.is_held, cm.held_reason, cm.is_shadowed,
   u.username,
   u.pfp,
   u.role
@@ -370,14 +651,20 @@ FROM comment cm
 JOIN "user" u ON cm.user_id = u.user_id
 WHERE cm.content_id = $1
   AND cm.is_deleted = false
+  AND cm.is_held = false
+  AND cm.is_shadowed = false
   AND cm.parent_comment_id IS NULL
-ORDER BY cm.created_at DESC
-LIMIT $2
+  AND ($2::timestamptz IS NULL
+    OR (cm.created_at, cm.comment_id) < ($2::timestamptz, $3::uuid))
+ORDER BY cm.created_at DESC, cm.comment_id DESC
+LIMIT $4
 `
 
 type ListContentCommentsParams struct {
-	ContentID pgtype.UUID
-	Limit     int32
+	ContentID      pgtype.UUID
+	AfterCreatedAt pgtype.Timestamptz
+	AfterCommentID pgtype.UUID
+	Limit          int32
 }
 
 type ListContentCommentsRow struct {
@@ -390,13 +677,21 @@ type ListContentCommentsRow struct {
 	UpdatedAt       pgtype.Timestamptz
 	IsDeleted       pgtype.Bool
 	ParentCommentID pgtype.UUID
+	IsHeld          bool
+	HeldReason      string
+	IsShadowed      bool
 	Username        string
 	Pfp             string
 	Role            string
 }
 
 func (q *Queries) ListContentComments(ctx context.Context, arg ListContentCommentsParams) ([]ListContentCommentsRow, error) {
-	rows, err := q.db.Query(ctx, listContentComments, arg.ContentID, arg.Limit)
+	rows, err := q.db.Query(ctx, listContentComments,
+		arg.ContentID,
+		arg.AfterCreatedAt,
+		arg.AfterCommentID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -414,6 +709,9 @@ func (q *Queries) ListContentComments(ctx context.Context, arg ListContentCommen
 			&i.UpdatedAt,
 			&i.IsDeleted,
 			&i.ParentCommentID,
+			&i.IsHeld,
+			&i.HeldReason,
+			&i.IsShadowed,
 			&i.Username,
 			&i.Pfp,
 			&i.Role,
@@ -430,7 +728,7 @@ func (q *Queries) ListContentComments(ctx context.Context, arg ListContentCommen
 
 const listContentCommentsByScore = `-- name: ListContentCommentsByScore :many
 SELECT
-  cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id,
+  cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id, cm.is_held, cm.held_reason, cm.is_shadowed,
   u.username,
   u.pfp,
   u.role
@@ -438,14 +736,20 @@ FROM comment cm
 JOIN "user" u ON cm.user_id = u.user_id
 WHERE cm.content_id = $1
   AND cm.is_deleted = false
+  AND cm.is_held = false
+  AND cm.is_shadowed = false
   AND cm.parent_comment_id IS NULL
-ORDER BY cm.score DESC
-LIMIT $2
+  AND ($2::int IS NULL
+    OR (cm.score, cm.comment_id) < ($2::int, $3::uuid))
+ORDER BY cm.score DESC, cm.comment_id DESC
+LIMIT $4
 `
 
 type ListContentCommentsByScoreParams struct {
-	ContentID pgtype.UUID
-	Limit     int32
+	ContentID      pgtype.UUID
+	AfterScore     pgtype.Int4
+	AfterCommentID pgtype.UUID
+	Limit          int32
 }
 
 type ListContentCommentsByScoreRow struct {
@@ -458,13 +762,21 @@ type ListContentCommentsByScoreRow struct {
 	UpdatedAt       pgtype.Timestamptz
 	IsDeleted       pgtype.Bool
 	ParentCommentID pgtype.UUID
+	IsHeld          bool
+	HeldReason      string
+	IsShadowed      bool
 	Username        string
 	Pfp             string
 	Role            string
 }
 
 func (q *Queries) ListContentCommentsByScore(ctx context.Context, arg ListContentCommentsByScoreParams) ([]ListContentCommentsByScoreRow, error) {
-	rows, err := q.db.Query(ctx, listContentCommentsByScore, arg.ContentID, arg.Limit)
+	rows, err := q.db.Query(ctx, listContentCommentsByScore,
+		arg.ContentID,
+		arg.AfterScore,
+		arg.AfterCommentID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -482,6 +794,163 @@ func (q *Queries) ListContentCommentsByScore(ctx context.Context, arg ListConten
 			&i.UpdatedAt,
 			&i.IsDeleted,
 			&i.ParentCommentID,
+			&i.IsHeld,
+			&i.HeldReason,
+			&i.IsShadowed,
+			&i.Username,
+			&i.Pfp,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHeldComments = `-- name: ListHeldComments :many
+SELECT
+  cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id, cm.is_held, cm.held_reason, cm.is_shadowed,
+  u.username,
+  u.pfp,
+  c.title AS content_title
+FROM comment cm
+JOIN "user" u ON cm.user_id = u.user_id
+JOIN content c ON cm.content_id = c.content_id
+WHERE cm.is_held = true
+  AND cm.is_deleted = false
+ORDER BY cm.created_at ASC
+LIMIT $1
+`
+
+type ListHeldCommentsRow struct {
+	CommentID       pgtype.UUID
+	ContentID       pgtype.UUID
+	UserID          pgtype.UUID
+	CommentText     string
+	Score           int32
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	IsDeleted       pgtype.Bool
+	ParentCommentID pgtype.UUID
+	IsHeld          bool
+	HeldReason      string
+	IsShadowed      bool
+	Username        string
+	Pfp             string
+	ContentTitle    string
+}
+
+func (q *Queries) ListHeldComments(ctx context.Context, limit int32) ([]ListHeldCommentsRow, error) {
+	rows, err := q.db.Query(ctx, listHeldComments, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHeldCommentsRow
+	for rows.Next() {
+		var i ListHeldCommentsRow
+		if err := rows.Scan(
+			&i.CommentID,
+			&i.ContentID,
+			&i.UserID,
+			&i.CommentText,
+			&i.Score,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.IsDeleted,
+			&i.ParentCommentID,
+			&i.IsHeld,
+			&i.HeldReason,
+			&i.IsShadowed,
+			&i.Username,
+			&i.Pfp,
+			&i.ContentTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listShadowedComments = `-- name: ListShadowedComments :many
+SELECT
+  cm.comment_id, cm.content_id, cm.user_id, cm.comment_text, cm.score, cm.created_at, cm.updated_at, cm.is_deleted, cm.parent_comment_id, cm.is_held, cm.held_reason, cm.is_shadowed,
+  u.username,
+  u.pfp,
+  u.role
+FROM comment cm
+JOIN "user" u ON cm.user_id = u.user_id
+WHERE cm.content_id = $1
+  AND cm.user_id = $2
+  AND cm.parent_comment_id IS NOT DISTINCT FROM $3::uuid
+  AND cm.is_shadowed = true
+  AND cm.is_deleted = false
+ORDER BY cm.created_at DESC
+LIMIT $4
+`
+
+type ListShadowedCommentsParams struct {
+	ContentID       pgtype.UUID
+	UserID          pgtype.UUID
+	ParentCommentID pgtype.UUID
+	Limit           int32
+}
+
+type ListShadowedCommentsRow struct {
+	CommentID       pgtype.UUID
+	ContentID       pgtype.UUID
+	UserID          pgtype.UUID
+	CommentText     string
+	Score           int32
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	IsDeleted       pgtype.Bool
+	ParentCommentID pgtype.UUID
+	IsHeld          bool
+	HeldReason      string
+	IsShadowed      bool
+	Username        string
+	Pfp             string
+	Role            string
+}
+
+// The hidden comments of a shadow-banned user under an article or a comment,
+// shown only to their author
+func (q *Queries) ListShadowedComments(ctx context.Context, arg ListShadowedCommentsParams) ([]ListShadowedCommentsRow, error) {
+	rows, err := q.db.Query(ctx, listShadowedComments,
+		arg.ContentID,
+		arg.UserID,
+		arg.ParentCommentID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListShadowedCommentsRow
+	for rows.Next() {
+		var i ListShadowedCommentsRow
+		if err := rows.Scan(
+			&i.CommentID,
+			&i.ContentID,
+			&i.UserID,
+			&i.CommentText,
+			&i.Score,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.IsDeleted,
+			&i.ParentCommentID,
+			&i.IsHeld,
+			&i.HeldReason,
+			&i.IsShadowed,
 			&i.Username,
 			&i.Pfp,
 			&i.Role,
@@ -523,35 +992,126 @@ func (q *Queries) SoftDeleteComment(ctx context.Context, commentID pgtype.UUID)
 	return i, err
 }
 
+const unshadowComments = `-- name: UnshadowComments :many
+WITH unshadowed AS (
+  UPDATE comment c
+  SET is_shadowed = false
+  WHERE c.is_shadowed = true
+    AND ($1::uuid IS NULL OR c.user_id = $1::uuid)
+    AND NOT EXISTS (
+      SELECT 1 FROM user_sanction s
+      WHERE s.user_id = c.user_id
+        AND s.kind = 'shadowban'
+        AND s.lifted_at IS NULL
+        AND (s.expires_at IS NULL OR s.expires_at > now())
+    )
+  RETURNING c.comment_id, c.content_id, c.parent_comment_id, c.is_deleted, c.is_held
+), counted AS (
+  UPDATE content
+  SET comment_count = comment_count + u.count
+  FROM (
+    SELECT content_id, COUNT(*)::INT AS count
+    FROM unshadowed
+    WHERE is_deleted IS NOT TRUE AND is_held = false
+    GROUP BY content_id
+  ) u
+  WHERE content.content_id = u.content_id
+)
+SELECT comment_id, content_id, parent_comment_id
+FROM unshadowed
+`
+
+type UnshadowCommentsRow struct {
+	CommentID       pgtype.UUID
+	ContentID       pgtype.UUID
+	ParentCommentID pgtype.UUID
+}
+
+// Publishes the comments posted under a shadow-ban that is no longer in
+// force, of user_id or of every user when it is null, and counts them on
+// their articles
+func (q *Queries) UnshadowComments(ctx context.Context, userID pgtype.UUID) ([]UnshadowCommentsRow, error) {
+	rows, err := q.db.Query(ctx, unshadowComments, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnshadowCommentsRow
+	for rows.Next() {
+		var i UnshadowCommentsRow
+		if err := rows.Scan(&i.CommentID, &i.ContentID, &i.ParentCommentID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateComment = `-- name: UpdateComment :one
+WITH revision AS (
+  INSERT INTO comment_revision (comment_id, comment_text)
+  SELECT comment_id, comment_text
+  FROM comment
+  WHERE comment_id = $1 AND is_deleted = false
+)
 UPDATE comment
 SET 
-  comment_text = $1,
-  updated_at = now()
+  comment_text = $2,
+  updated_at = now(),
+  -- An edit can put a comment on hold, only a moderator takes it off
+  is_held = is_held OR $3::bool,
+  held_reason = CASE WHEN $3::bool THEN $4::text ELSE held_reason END
 WHERE 
-  comment_id = $2
+  comment_id = $1
   AND is_deleted = false
 RETURNING
   comment_id,
+  content_id,
+  parent_comment_id,
   comment_text,
-  updated_at
+  created_at,
+  updated_at,
+  is_held
 `
 
 type UpdateCommentParams struct {
-	CommentText string
 	CommentID   pgtype.UUID
+	CommentText string
+	IsHeld      bool
+	HeldReason  string
 }
 
 type UpdateCommentRow struct {
-	CommentID   pgtype.UUID
-	CommentText string
-	UpdatedAt   pgtype.Timestamptz
+	CommentID       pgtype.UUID
+	ContentID       pgtype.UUID
+	ParentCommentID pgtype.UUID
+	CommentText     string
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	IsHeld          bool
 }
 
+// The text being replaced is kept in comment_revision
 func (q *Queries) UpdateComment(ctx context.Context, arg UpdateCommentParams) (UpdateCommentRow, error) {
-	row := q.db.QueryRow(ctx, updateComment, arg.CommentText, arg.CommentID)
+	row := q.db.QueryRow(ctx, updateComment,
+		arg.CommentID,
+		arg.CommentText,
+		arg.IsHeld,
+		arg.HeldReason,
+	)
 	var i UpdateCommentRow
-	err := row.Scan(&i.CommentID, &i.CommentText, &i.UpdatedAt)
+	err := row.Scan(
+		&i.CommentID,
+		&i.ContentID,
+		&i.ParentCommentID,
+		&i.CommentText,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.IsHeld,
+	)
 	return i, err
 }
 
@@ -571,7 +1131,7 @@ SET
   ),
   updated_at = c.updated_at  -- Explicitly keep the current value
 WHERE c.comment_id = $1
-RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id
+RETURNING comment_id, content_id, user_id, comment_text, score, created_at, updated_at, is_deleted, parent_comment_id, is_held, held_reason, is_shadowed
 `
 
 func (q *Queries) UpdateCommentScore(ctx context.Context, commentID pgtype.UUID) (Comment, error) {
@@ -587,6 +1147,9 @@ func (q *Queries) UpdateCommentScore(ctx context.Context, commentID pgtype.UUID)
 		&i.UpdatedAt,
 		&i.IsDeleted,
 		&i.ParentCommentID,
+		&i.IsHeld,
+		&i.HeldReason,
+		&i.IsShadowed,
 	)
 	return i, err
 }
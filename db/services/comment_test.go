@@ -2,9 +2,11 @@ package db
 
 import (
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/00mark0/macva-press/utils"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"context"
 
@@ -132,7 +134,78 @@ func TestUpdateComment(t *testing.T) {
 	require.NotEqual(t, comment.CommentText, updatedComment.CommentText)
 	require.NotEqual(t, comment.UpdatedAt, updatedComment.UpdatedAt)
 	require.Equal(t, comment.CommentID, updatedComment.CommentID)
+	require.Equal(t, comment.ContentID, updatedComment.ContentID)
 	require.Equal(t, arg.CommentText, updatedComment.CommentText)
+	require.Equal(t, comment.CreatedAt, updatedComment.CreatedAt)
+}
+
+func TestShadowedComments(t *testing.T) {
+	comment := createRandomComment(t)
+	user := createRandomUser(t)
+
+	shadowed, err := testQueries.CreateComment(context.Background(), CreateCommentParams{
+		ContentID:   comment.ContentID,
+		UserID:      user.UserID,
+		CommentText: utils.RandomString(20),
+		IsShadowed:  true,
+	})
+	require.NoError(t, err)
+	require.True(t, shadowed.IsShadowed)
+
+	// Hidden from everyone
+	comments, err := testQueries.ListContentComments(context.Background(), ListContentCommentsParams{ContentID: comment.ContentID, Limit: 100})
+	require.NoError(t, err)
+	for _, c := range comments {
+		require.NotEqual(t, shadowed.CommentID, c.CommentID)
+	}
+
+	// Except their author
+	own, err := testQueries.ListShadowedComments(context.Background(), ListShadowedCommentsParams{
+		ContentID: comment.ContentID,
+		UserID:    user.UserID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, own, 1)
+	require.Equal(t, shadowed.CommentID, own[0].CommentID)
+
+	// Replies are listed under their parent only
+	own, err = testQueries.ListShadowedComments(context.Background(), ListShadowedCommentsParams{
+		ContentID:       comment.ContentID,
+		UserID:          user.UserID,
+		ParentCommentID: comment.CommentID,
+		Limit:           10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, own)
+}
+
+func TestListCommentRevisions(t *testing.T) {
+	comment := createRandomComment(t)
+
+	revisions, err := testQueries.ListCommentRevisions(context.Background(), comment.CommentID)
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+
+	first := utils.RandomString(10)
+	_, err = testQueries.UpdateComment(context.Background(), UpdateCommentParams{
+		CommentID:   comment.CommentID,
+		CommentText: first,
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.UpdateComment(context.Background(), UpdateCommentParams{
+		CommentID:   comment.CommentID,
+		CommentText: utils.RandomString(10),
+	})
+	require.NoError(t, err)
+
+	// Each revision keeps the text the edit replaced, oldest first
+	revisions, err = testQueries.ListCommentRevisions(context.Background(), comment.CommentID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, comment.CommentText, revisions[0].CommentText)
+	require.Equal(t, first, revisions[1].CommentText)
 }
 
 // this one tests both ListContentComments and SoftDeleteComment
@@ -298,4 +371,247 @@ func TestListContentCommentsByScore(t *testing.T) {
 	require.Equal(t, int32(3), comments[0].Score)
 	require.Equal(t, int32(2), comments[1].Score)
 	require.Equal(t, int32(1), comments[2].Score)
+	// The next page starts right after the cursor
+	nextPage, err := testQueries.ListContentCommentsByScore(context.Background(), ListContentCommentsByScoreParams{
+		ContentID:      contentPub.ContentID,
+		AfterScore:     pgtype.Int4{Int32: comments[0].Score, Valid: true},
+		AfterCommentID: comments[0].CommentID,
+		Limit:          10,
+	})
+	require.NoError(t, err)
+	require.Len(t, nextPage, 2)
+	require.Equal(t, comment2.CommentID, nextPage[0].CommentID)
+	require.Equal(t, comment3.CommentID, nextPage[1].CommentID)
+}
+
+func TestListContentCommentsKeyset(t *testing.T) {
+	content := createRandomContent(t)
+
+	contentPub, err := testQueries.PublishContent(context.Background(), content.ContentID)
+	require.NoError(t, err)
+
+	users, err := testQueries.GetActiveUsers(context.Background(), 10)
+	require.NoError(t, err)
+	require.NotEmpty(t, users)
+
+	var created []Comment
+	for i := 0; i < 5; i++ {
+		created = append(created, createCommentInteractive(contentPub, users[0]))
+	}
+
+	var listed []ListContentCommentsRow
+	var cursor ListContentCommentsRow
+	for page := 0; page < 3; page++ {
+		arg := ListContentCommentsParams{
+			ContentID: contentPub.ContentID,
+			Limit:     2,
+		}
+		if page > 0 {
+			arg.AfterCreatedAt = cursor.CreatedAt
+			arg.AfterCommentID = cursor.CommentID
+		}
+
+		comments, err := testQueries.ListContentComments(context.Background(), arg)
+		require.NoError(t, err)
+		require.NotEmpty(t, comments)
+
+		listed = append(listed, comments...)
+		cursor = comments[len(comments)-1]
+	}
+
+	// Every comment once, newest first
+	require.Len(t, listed, len(created))
+	for i, comment := range listed {
+		require.Equal(t, created[len(created)-1-i].CommentID, comment.CommentID)
+	}
+}
+
+func TestListCommentRepliesKeyset(t *testing.T) {
+	parent := createRandomComment(t)
+
+	var created []Comment
+	for i := 0; i < 3; i++ {
+		reply, err := testQueries.CreateReply(context.Background(), CreateReplyParams{
+			ContentID:       parent.ContentID,
+			UserID:          parent.UserID,
+			CommentText:     Loremipsumgen.Sentence(),
+			ParentCommentID: parent.CommentID,
+		})
+		require.NoError(t, err)
+		created = append(created, reply)
+	}
+
+	firstPage, err := testQueries.ListCommentReplies(context.Background(), ListCommentRepliesParams{
+		ParentCommentID: parent.CommentID,
+		Limit:           2,
+	})
+	require.NoError(t, err)
+	require.Len(t, firstPage, 2)
+
+	secondPage, err := testQueries.ListCommentReplies(context.Background(), ListCommentRepliesParams{
+		ParentCommentID: parent.CommentID,
+		AfterCreatedAt:  firstPage[1].CreatedAt,
+		AfterCommentID:  firstPage[1].CommentID,
+		Limit:           2,
+	})
+	require.NoError(t, err)
+	require.Len(t, secondPage, 1)
+
+	// Oldest first
+	require.Equal(t, created[0].CommentID, firstPage[0].CommentID)
+	require.Equal(t, created[1].CommentID, firstPage[1].CommentID)
+	require.Equal(t, created[2].CommentID, secondPage[0].CommentID)
+}
+
+func TestListCommentThread(t *testing.T) {
+	root := createRandomComment(t)
+
+	// root <- first <- nested, root <- second
+	first, err := testQueries.CreateReply(context.Background(), CreateReplyParams{
+		ContentID:       root.ContentID,
+		UserID:          root.UserID,
+		CommentText:     Loremipsumgen.Sentence(),
+		ParentCommentID: root.CommentID,
+	})
+	require.NoError(t, err)
+
+	second, err := testQueries.CreateReply(context.Background(), CreateReplyParams{
+		ContentID:       root.ContentID,
+		UserID:          root.UserID,
+		CommentText:     Loremipsumgen.Sentence(),
+		ParentCommentID: root.CommentID,
+	})
+	require.NoError(t, err)
+
+	nested, err := testQueries.CreateReply(context.Background(), CreateReplyParams{
+		ContentID:       root.ContentID,
+		UserID:          root.UserID,
+		CommentText:     Loremipsumgen.Sentence(),
+		ParentCommentID: first.CommentID,
+	})
+	require.NoError(t, err)
+
+	ancestors, err := testQueries.ListCommentAncestors(context.Background(), nested.CommentID)
+	require.NoError(t, err)
+	require.Len(t, ancestors, 3)
+	require.Equal(t, root.CommentID, ancestors[0].CommentID)
+	require.Equal(t, first.CommentID, ancestors[1].CommentID)
+	require.Equal(t, nested.CommentID, ancestors[2].CommentID)
+	require.Equal(t, int32(0), ancestors[2].Distance)
+
+	thread, err := testQueries.ListCommentThread(context.Background(), ListCommentThreadParams{
+		RootCommentID: root.CommentID,
+		Limit:         100,
+	})
+	require.NoError(t, err)
+	require.Len(t, thread, 4)
+
+	// Each reply right after the comment it answers
+	require.Equal(t, root.CommentID, thread[0].CommentID)
+	require.Equal(t, first.CommentID, thread[1].CommentID)
+	require.Equal(t, nested.CommentID, thread[2].CommentID)
+	require.Equal(t, second.CommentID, thread[3].CommentID)
+
+	require.Equal(t, int32(2), thread[2].Depth)
+	require.Equal(t, int64(2), thread[0].ReplyCount)
+	require.Equal(t, int64(1), thread[1].ReplyCount)
+}
+
+func TestHeldComments(t *testing.T) {
+	visible := createRandomComment(t)
+
+	held, err := testQueries.CreateComment(context.Background(), CreateCommentParams{
+		ContentID:   visible.ContentID,
+		UserID:      visible.UserID,
+		CommentText: Loremipsumgen.Sentence(),
+		IsHeld:      true,
+		HeldReason:  "Previše linkova (3)",
+	})
+	require.NoError(t, err)
+	require.True(t, held.IsHeld)
+	require.Equal(t, "Previše linkova (3)", held.HeldReason)
+
+	// Held comments stay out of the article
+	comments, err := testQueries.ListContentComments(context.Background(), ListContentCommentsParams{
+		ContentID: visible.ContentID,
+		Limit:     1000,
+	})
+	require.NoError(t, err)
+	for _, comment := range comments {
+		require.NotEqual(t, held.CommentID, comment.CommentID)
+	}
+
+	heldComments, err := testQueries.ListHeldComments(context.Background(), 1000)
+	require.NoError(t, err)
+	found := false
+	for _, comment := range heldComments {
+		if comment.CommentID == held.CommentID {
+			found = true
+			require.NotEmpty(t, comment.ContentTitle)
+		}
+	}
+	require.True(t, found)
+
+	count, err := testQueries.CountHeldComments(context.Background())
+	require.NoError(t, err)
+	require.NotZero(t, count)
+
+	// An edit that passes the filters doesn't release a held comment
+	updated, err := testQueries.UpdateComment(context.Background(), UpdateCommentParams{
+		CommentID:   held.CommentID,
+		CommentText: Loremipsumgen.Sentence(),
+	})
+	require.NoError(t, err)
+	require.True(t, updated.IsHeld)
+
+	approved, err := testQueries.ApproveComment(context.Background(), held.CommentID)
+	require.NoError(t, err)
+	require.False(t, approved.IsHeld)
+	require.Empty(t, approved.HeldReason)
+
+	// Only held comments can be approved
+	_, err = testQueries.ApproveComment(context.Background(), held.CommentID)
+	require.Error(t, err)
+
+	// An edit can put it back on hold
+	updated, err = testQueries.UpdateComment(context.Background(), UpdateCommentParams{
+		CommentID:   held.CommentID,
+		CommentText: Loremipsumgen.Sentence(),
+		IsHeld:      true,
+		HeldReason:  "Zabranjena reč: kazino",
+	})
+	require.NoError(t, err)
+	require.True(t, updated.IsHeld)
+}
+
+func TestCountDuplicateComments(t *testing.T) {
+	first := createRandomComment(t)
+	second := createRandomComment(t)
+
+	text := "Najbolji kazino bonusi na " + utils.RandomString(12)
+
+	_, err := testQueries.CreateComment(context.Background(), CreateCommentParams{
+		ContentID:   first.ContentID,
+		UserID:      first.UserID,
+		CommentText: text,
+	})
+	require.NoError(t, err)
+
+	// The same text, whatever the case, on another article
+	copies, err := testQueries.CountDuplicateComments(context.Background(), CountDuplicateCommentsParams{
+		CommentText: strings.ToUpper(text),
+		ContentID:   second.ContentID,
+	})
+	require.NoError(t, err)
+	if first.ContentID != second.ContentID {
+		require.Equal(t, int64(1), copies)
+	}
+
+	// The article it was posted on doesn't count
+	copies, err = testQueries.CountDuplicateComments(context.Background(), CountDuplicateCommentsParams{
+		CommentText: text,
+		ContentID:   first.ContentID,
+	})
+	require.NoError(t, err)
+	require.Zero(t, copies)
 }
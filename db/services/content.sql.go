@@ -15,18 +15,27 @@ const addThumbnail = `-- name: AddThumbnail :one
 UPDATE content
 SET
     thumbnail = $2,
+    thumbnail_focal_x = $3,
+    thumbnail_focal_y = $4,
     updated_at = now()
 WHERE content_id = $1
-RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted
+RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted, thumbnail_focal_x, thumbnail_focal_y, is_sponsored, sponsor_name, sponsor_logo
 `
 
 type AddThumbnailParams struct {
-	ContentID pgtype.UUID
-	Thumbnail pgtype.Text
+	ContentID       pgtype.UUID
+	Thumbnail       pgtype.Text
+	ThumbnailFocalX float32
+	ThumbnailFocalY float32
 }
 
 func (q *Queries) AddThumbnail(ctx context.Context, arg AddThumbnailParams) (Content, error) {
-	row := q.db.QueryRow(ctx, addThumbnail, arg.ContentID, arg.Thumbnail)
+	row := q.db.QueryRow(ctx, addThumbnail,
+		arg.ContentID,
+		arg.Thumbnail,
+		arg.ThumbnailFocalX,
+		arg.ThumbnailFocalY,
+	)
 	var i Content
 	err := row.Scan(
 		&i.ContentID,
@@ -49,6 +58,11 @@ func (q *Queries) AddThumbnail(ctx context.Context, arg AddThumbnailParams) (Con
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 	)
 	return i, err
 }
@@ -67,7 +81,7 @@ INSERT INTO content (
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
-RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted
+RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted, thumbnail_focal_x, thumbnail_focal_y, is_sponsored, sponsor_name, sponsor_logo
 `
 
 type CreateContentParams struct {
@@ -116,6 +130,11 @@ func (q *Queries) CreateContent(ctx context.Context, arg CreateContentParams) (C
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 	)
 	return i, err
 }
@@ -248,7 +267,7 @@ func (q *Queries) GetContentByCategoryCount(ctx context.Context, categoryID pgty
 
 const getContentBySlug = `-- name: GetContentBySlug :one
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name,
   (
@@ -287,6 +306,11 @@ type GetContentBySlugRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 	Tags                []string
@@ -316,6 +340,11 @@ func (q *Queries) GetContentBySlug(ctx context.Context, slug string) (GetContent
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 		&i.Username,
 		&i.CategoryName,
 		&i.Tags,
@@ -342,7 +371,7 @@ func (q *Queries) GetContentByTagCount(ctx context.Context, tagName string) (int
 
 const getContentDetails = `-- name: GetContentDetails :one
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name,
   (
@@ -378,6 +407,11 @@ type GetContentDetailsRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 	Tags                []string
@@ -407,6 +441,11 @@ func (q *Queries) GetContentDetails(ctx context.Context, contentID pgtype.UUID)
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 		&i.Username,
 		&i.CategoryName,
 		&i.Tags,
@@ -475,7 +514,7 @@ func (q *Queries) GetSearchContentCount(ctx context.Context, searchTerm string)
 const hardDeleteContent = `-- name: HardDeleteContent :one
 DELETE FROM content
 WHERE content_id = $1
-RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted
+RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted, thumbnail_focal_x, thumbnail_focal_y, is_sponsored, sponsor_name, sponsor_logo
 `
 
 func (q *Queries) HardDeleteContent(ctx context.Context, contentID pgtype.UUID) (Content, error) {
@@ -502,6 +541,11 @@ func (q *Queries) HardDeleteContent(ctx context.Context, contentID pgtype.UUID)
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 	)
 	return i, err
 }
@@ -556,7 +600,7 @@ func (q *Queries) InsertOrUpdateContentReaction(ctx context.Context, arg InsertO
 
 const listContentByCategory = `-- name: ListContentByCategory :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -596,6 +640,11 @@ type ListContentByCategoryRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -630,6 +679,11 @@ func (q *Queries) ListContentByCategory(ctx context.Context, arg ListContentByCa
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -645,7 +699,7 @@ func (q *Queries) ListContentByCategory(ctx context.Context, arg ListContentByCa
 
 const listContentByCategoryLimit = `-- name: ListContentByCategoryLimit :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username
 FROM content c
 JOIN "user" u ON c.user_id = u.user_id
@@ -682,6 +736,11 @@ type ListContentByCategoryLimitRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 }
 
@@ -715,6 +774,11 @@ func (q *Queries) ListContentByCategoryLimit(ctx context.Context, arg ListConten
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 		); err != nil {
 			return nil, err
@@ -729,7 +793,7 @@ func (q *Queries) ListContentByCategoryLimit(ctx context.Context, arg ListConten
 
 const listContentByTag = `-- name: ListContentByTag :many
 SELECT DISTINCT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -771,6 +835,11 @@ type ListContentByTagRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -805,6 +874,11 @@ func (q *Queries) ListContentByTag(ctx context.Context, arg ListContentByTagPara
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -820,7 +894,7 @@ func (q *Queries) ListContentByTag(ctx context.Context, arg ListContentByTagPara
 
 const listContentByTagLimit = `-- name: ListContentByTagLimit :many
 SELECT DISTINCT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -861,6 +935,11 @@ type ListContentByTagLimitRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -895,6 +974,11 @@ func (q *Queries) ListContentByTagLimit(ctx context.Context, arg ListContentByTa
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -910,7 +994,7 @@ func (q *Queries) ListContentByTagLimit(ctx context.Context, arg ListContentByTa
 
 const listDeletedContent = `-- name: ListDeletedContent :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -942,6 +1026,11 @@ type ListDeletedContentRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -976,6 +1065,11 @@ func (q *Queries) ListDeletedContent(ctx context.Context, limit int32) ([]ListDe
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -991,7 +1085,7 @@ func (q *Queries) ListDeletedContent(ctx context.Context, limit int32) ([]ListDe
 
 const listDeletedContentOldest = `-- name: ListDeletedContentOldest :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1023,6 +1117,11 @@ type ListDeletedContentOldestRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1057,6 +1156,11 @@ func (q *Queries) ListDeletedContentOldest(ctx context.Context, limit int32) ([]
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1072,7 +1176,7 @@ func (q *Queries) ListDeletedContentOldest(ctx context.Context, limit int32) ([]
 
 const listDeletedContentTitle = `-- name: ListDeletedContentTitle :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1104,6 +1208,11 @@ type ListDeletedContentTitleRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1138,6 +1247,11 @@ func (q *Queries) ListDeletedContentTitle(ctx context.Context, limit int32) ([]L
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1153,7 +1267,7 @@ func (q *Queries) ListDeletedContentTitle(ctx context.Context, limit int32) ([]L
 
 const listDraftContent = `-- name: ListDraftContent :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1186,6 +1300,11 @@ type ListDraftContentRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1220,6 +1339,11 @@ func (q *Queries) ListDraftContent(ctx context.Context, limit int32) ([]ListDraf
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1235,7 +1359,7 @@ func (q *Queries) ListDraftContent(ctx context.Context, limit int32) ([]ListDraf
 
 const listDraftContentOldest = `-- name: ListDraftContentOldest :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1268,6 +1392,11 @@ type ListDraftContentOldestRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1302,6 +1431,11 @@ func (q *Queries) ListDraftContentOldest(ctx context.Context, limit int32) ([]Li
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1317,7 +1451,7 @@ func (q *Queries) ListDraftContentOldest(ctx context.Context, limit int32) ([]Li
 
 const listDraftContentTitle = `-- name: ListDraftContentTitle :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1350,6 +1484,11 @@ type ListDraftContentTitleRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1384,6 +1523,11 @@ func (q *Queries) ListDraftContentTitle(ctx context.Context, limit int32) ([]Lis
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1399,7 +1543,7 @@ func (q *Queries) ListDraftContentTitle(ctx context.Context, limit int32) ([]Lis
 
 const listPublishedContent = `-- name: ListPublishedContent :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1437,6 +1581,11 @@ type ListPublishedContentRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1471,6 +1620,11 @@ func (q *Queries) ListPublishedContent(ctx context.Context, arg ListPublishedCon
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1486,7 +1640,7 @@ func (q *Queries) ListPublishedContent(ctx context.Context, arg ListPublishedCon
 
 const listPublishedContentLimit = `-- name: ListPublishedContentLimit :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1519,6 +1673,11 @@ type ListPublishedContentLimitRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1553,6 +1712,11 @@ func (q *Queries) ListPublishedContentLimit(ctx context.Context, limit int32) ([
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1568,7 +1732,7 @@ func (q *Queries) ListPublishedContentLimit(ctx context.Context, limit int32) ([
 
 const listPublishedContentLimitOldest = `-- name: ListPublishedContentLimitOldest :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1601,6 +1765,11 @@ type ListPublishedContentLimitOldestRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1635,6 +1804,11 @@ func (q *Queries) ListPublishedContentLimitOldest(ctx context.Context, limit int
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1650,7 +1824,7 @@ func (q *Queries) ListPublishedContentLimitOldest(ctx context.Context, limit int
 
 const listPublishedContentLimitTitle = `-- name: ListPublishedContentLimitTitle :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1683,6 +1857,11 @@ type ListPublishedContentLimitTitleRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -1717,6 +1896,11 @@ func (q *Queries) ListPublishedContentLimitTitle(ctx context.Context, limit int3
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -1731,11 +1915,12 @@ func (q *Queries) ListPublishedContentLimitTitle(ctx context.Context, limit int3
 }
 
 const listRelatedContent = `-- name: ListRelatedContent :many
-SELECT c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted
+SELECT c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo
 FROM content c
 WHERE c.content_id <> $1
   AND c.status = 'published'
   AND c.is_deleted = false
+  AND c.is_sponsored = false
   AND c.category_id = (SELECT category_id FROM content WHERE content_id = $1)
   AND EXISTS (
       SELECT 1
@@ -1786,6 +1971,11 @@ func (q *Queries) ListRelatedContent(ctx context.Context, arg ListRelatedContent
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 		); err != nil {
 			return nil, err
 		}
@@ -1799,13 +1989,14 @@ func (q *Queries) ListRelatedContent(ctx context.Context, arg ListRelatedContent
 
 const listTrendingContent = `-- name: ListTrendingContent :many
 SELECT 
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   cat.category_name,
   (c.view_count + c.like_count + c.comment_count) AS total_interactions
 FROM content c
 JOIN category cat ON c.category_id = cat.category_id
 WHERE c.status = 'published'
   AND c.is_deleted = false
+  AND c.is_sponsored = false
   AND c.published_at >= $1
 ORDER BY total_interactions DESC
 LIMIT $2
@@ -1837,6 +2028,11 @@ type ListTrendingContentRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	CategoryName        string
 	TotalInteractions   int32
 }
@@ -1871,6 +2067,11 @@ func (q *Queries) ListTrendingContent(ctx context.Context, arg ListTrendingConte
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.CategoryName,
 			&i.TotalInteractions,
 		); err != nil {
@@ -1891,7 +2092,7 @@ SET
     published_at = now(),
     updated_at = now()
 WHERE content_id = $1
-RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted
+RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted, thumbnail_focal_x, thumbnail_focal_y, is_sponsored, sponsor_name, sponsor_logo
 `
 
 func (q *Queries) PublishContent(ctx context.Context, contentID pgtype.UUID) (Content, error) {
@@ -1918,13 +2119,43 @@ func (q *Queries) PublishContent(ctx context.Context, contentID pgtype.UUID) (Co
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 	)
 	return i, err
 }
 
+const replaceThumbnail = `-- name: ReplaceThumbnail :exec
+UPDATE content
+SET thumbnail = $1,
+    thumbnail_focal_x = $2,
+    thumbnail_focal_y = $3
+WHERE thumbnail = $4
+`
+
+type ReplaceThumbnailParams struct {
+	NewThumbnail    pgtype.Text
+	ThumbnailFocalX float32
+	ThumbnailFocalY float32
+	OldThumbnail    pgtype.Text
+}
+
+func (q *Queries) ReplaceThumbnail(ctx context.Context, arg ReplaceThumbnailParams) error {
+	_, err := q.db.Exec(ctx, replaceThumbnail,
+		arg.NewThumbnail,
+		arg.ThumbnailFocalX,
+		arg.ThumbnailFocalY,
+		arg.OldThumbnail,
+	)
+	return err
+}
+
 const searchContent = `-- name: SearchContent :many
 SELECT DISTINCT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -1970,6 +2201,11 @@ type SearchContentRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -2004,6 +2240,11 @@ func (q *Queries) SearchContent(ctx context.Context, arg SearchContentParams) ([
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -2019,7 +2260,7 @@ func (q *Queries) SearchContent(ctx context.Context, arg SearchContentParams) ([
 
 const searchDelContent = `-- name: SearchDelContent :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -2060,6 +2301,11 @@ type SearchDelContentRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -2094,6 +2340,11 @@ func (q *Queries) SearchDelContent(ctx context.Context, arg SearchDelContentPara
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -2109,7 +2360,7 @@ func (q *Queries) SearchDelContent(ctx context.Context, arg SearchDelContentPara
 
 const searchDraftContent = `-- name: SearchDraftContent :many
 SELECT
-  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted,
+  c.content_id, c.user_id, c.category_id, c.title, c.slug, c.thumbnail, c.content_description, c.comments_enabled, c.view_count_enabled, c.like_count_enabled, c.dislike_count_enabled, c.status, c.view_count, c.like_count, c.dislike_count, c.comment_count, c.created_at, c.updated_at, c.published_at, c.is_deleted, c.thumbnail_focal_x, c.thumbnail_focal_y, c.is_sponsored, c.sponsor_name, c.sponsor_logo,
   u.username,
   cat.category_name
 FROM content c
@@ -2151,6 +2402,11 @@ type SearchDraftContentRow struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 	Username            string
 	CategoryName        string
 }
@@ -2185,6 +2441,11 @@ func (q *Queries) SearchDraftContent(ctx context.Context, arg SearchDraftContent
 			&i.UpdatedAt,
 			&i.PublishedAt,
 			&i.IsDeleted,
+			&i.ThumbnailFocalX,
+			&i.ThumbnailFocalY,
+			&i.IsSponsored,
+			&i.SponsorName,
+			&i.SponsorLogo,
 			&i.Username,
 			&i.CategoryName,
 		); err != nil {
@@ -2205,7 +2466,7 @@ SET
     published_at = null,
     updated_at = now()
 WHERE content_id = $1
-RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted
+RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted, thumbnail_focal_x, thumbnail_focal_y, is_sponsored, sponsor_name, sponsor_logo
 `
 
 func (q *Queries) SoftDeleteContent(ctx context.Context, contentID pgtype.UUID) (Content, error) {
@@ -2232,6 +2493,11 @@ func (q *Queries) SoftDeleteContent(ctx context.Context, contentID pgtype.UUID)
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 	)
 	return i, err
 }
@@ -2244,7 +2510,7 @@ SET
     published_at = null,
     updated_at = now()
 WHERE content_id = $1
-RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted
+RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted, thumbnail_focal_x, thumbnail_focal_y, is_sponsored, sponsor_name, sponsor_logo
 `
 
 func (q *Queries) UnarchiveContent(ctx context.Context, contentID pgtype.UUID) (Content, error) {
@@ -2271,6 +2537,11 @@ func (q *Queries) UnarchiveContent(ctx context.Context, contentID pgtype.UUID) (
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 	)
 	return i, err
 }
@@ -2288,7 +2559,7 @@ SET
     slug= COALESCE($9, slug),
     updated_at = now()
 WHERE content_id = $1
-RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted
+RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted, thumbnail_focal_x, thumbnail_focal_y, is_sponsored, sponsor_name, sponsor_logo
 `
 
 type UpdateContentParams struct {
@@ -2337,6 +2608,11 @@ func (q *Queries) UpdateContent(ctx context.Context, arg UpdateContentParams) (C
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 	)
 	return i, err
 }
@@ -2356,7 +2632,7 @@ SET
   ),
   updated_at = now()
 WHERE c.content_id = $1 
-RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted
+RETURNING content_id, user_id, category_id, title, slug, thumbnail, content_description, comments_enabled, view_count_enabled, like_count_enabled, dislike_count_enabled, status, view_count, like_count, dislike_count, comment_count, created_at, updated_at, published_at, is_deleted, thumbnail_focal_x, thumbnail_focal_y, is_sponsored, sponsor_name, sponsor_logo
 `
 
 func (q *Queries) UpdateContentLikeDislikeCount(ctx context.Context, contentID pgtype.UUID) (Content, error) {
@@ -2383,6 +2659,64 @@ func (q *Queries) UpdateContentLikeDislikeCount(ctx context.Context, contentID p
 		&i.UpdatedAt,
 		&i.PublishedAt,
 		&i.IsDeleted,
+		&i.ThumbnailFocalX,
+		&i.ThumbnailFocalY,
+		&i.IsSponsored,
+		&i.SponsorName,
+		&i.SponsorLogo,
 	)
 	return i, err
 }
+
+const updateContentSponsor = `-- name: UpdateContentSponsor :exec
+UPDATE content
+SET
+    is_sponsored = $2,
+    sponsor_name = $3,
+    sponsor_logo = $4,
+    updated_at = now()
+WHERE content_id = $1
+`
+
+type UpdateContentSponsorParams struct {
+	ContentID   pgtype.UUID
+	IsSponsored bool
+	SponsorName pgtype.Text
+	SponsorLogo pgtype.Text
+}
+
+func (q *Queries) UpdateContentSponsor(ctx context.Context, arg UpdateContentSponsorParams) error {
+	_, err := q.db.Exec(ctx, updateContentSponsor,
+		arg.ContentID,
+		arg.IsSponsored,
+		arg.SponsorName,
+		arg.SponsorLogo,
+	)
+	return err
+}
+
+const updateThumbnailFocalPoint = `-- name: UpdateThumbnailFocalPoint :exec
+UPDATE content
+SET
+    thumbnail_focal_x = $1,
+    thumbnail_focal_y = $2
+WHERE content_id = $3
+  AND thumbnail = $4
+`
+
+type UpdateThumbnailFocalPointParams struct {
+	ThumbnailFocalX float32
+	ThumbnailFocalY float32
+	ContentID       pgtype.UUID
+	Thumbnail       pgtype.Text
+}
+
+func (q *Queries) UpdateThumbnailFocalPoint(ctx context.Context, arg UpdateThumbnailFocalPointParams) error {
+	_, err := q.db.Exec(ctx, updateThumbnailFocalPoint,
+		arg.ThumbnailFocalX,
+		arg.ThumbnailFocalY,
+		arg.ContentID,
+		arg.Thumbnail,
+	)
+	return err
+}
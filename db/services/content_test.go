@@ -9,6 +9,7 @@ import (
 	//"github.com/00mark0/macva-press/utils"
 	"github.com/00mark0/macva-press/utils"
 	"github.com/go-loremipsum/loremipsum"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
 
@@ -96,6 +97,37 @@ func TestPublishContent(t *testing.T) {
 	}
 }
 
+func TestThumbnailFocalPoint(t *testing.T) {
+	content := createRandomContent(t)
+	require.Equal(t, float32(0.5), content.ThumbnailFocalX)
+	require.Equal(t, float32(0.5), content.ThumbnailFocalY)
+
+	thumbnail := pgtype.Text{String: "/static/uploads/focal.webp", Valid: true}
+	content, err := testQueries.AddThumbnail(context.Background(), AddThumbnailParams{
+		ContentID:       content.ContentID,
+		Thumbnail:       thumbnail,
+		ThumbnailFocalX: 0.2,
+		ThumbnailFocalY: 0.3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, thumbnail, content.Thumbnail)
+	require.Equal(t, float32(0.2), content.ThumbnailFocalX)
+	require.Equal(t, float32(0.3), content.ThumbnailFocalY)
+
+	err = testQueries.UpdateThumbnailFocalPoint(context.Background(), UpdateThumbnailFocalPointParams{
+		ThumbnailFocalX: 0.8,
+		ThumbnailFocalY: 0.1,
+		ContentID:       content.ContentID,
+		Thumbnail:       thumbnail,
+	})
+	require.NoError(t, err)
+
+	details, err := testQueries.GetContentDetails(context.Background(), content.ContentID)
+	require.NoError(t, err)
+	require.Equal(t, float32(0.8), details.ThumbnailFocalX)
+	require.Equal(t, float32(0.1), details.ThumbnailFocalY)
+}
+
 func TestSoftDeleteContent(t *testing.T) {
 	content1 := createRandomContent(t)
 
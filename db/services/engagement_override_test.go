@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetEngagementOverride(t *testing.T) {
+	content := createRandomContent(t)
+
+	rule, err := testQueries.SetCategoryEngagementOverride(context.Background(), SetCategoryEngagementOverrideParams{
+		CategoryID: content.CategoryID,
+		Setting:    "comments",
+		Enabled:    false,
+	})
+	require.NoError(t, err)
+	require.Equal(t, content.CategoryID, rule.CategoryID)
+	require.False(t, rule.ContentID.Valid)
+	require.False(t, rule.AfterHours.Valid)
+
+	// Setting it again replaces the category's rule
+	updated, err := testQueries.SetCategoryEngagementOverride(context.Background(), SetCategoryEngagementOverrideParams{
+		CategoryID: content.CategoryID,
+		Setting:    "comments",
+		Enabled:    false,
+		AfterHours: pgtype.Int4{Int32: 72, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, rule.ID, updated.ID)
+	require.Equal(t, int32(72), updated.AfterHours.Int32)
+
+	articleRule, err := testQueries.SetContentEngagementOverride(context.Background(), SetContentEngagementOverrideParams{
+		ContentID: content.ContentID,
+		Setting:   "comments",
+		Enabled:   true,
+	})
+	require.NoError(t, err)
+
+	categoryRules, err := testQueries.ListCategoryEngagementOverrides(context.Background(), content.CategoryID)
+	require.NoError(t, err)
+	require.Len(t, categoryRules, 1)
+
+	articleRules, err := testQueries.ListContentEngagementOverrides(context.Background(), content.ContentID)
+	require.NoError(t, err)
+	require.Len(t, articleRules, 1)
+	require.Equal(t, articleRule.ID, articleRules[0].ID)
+
+	all, err := testQueries.ListEngagementOverrides(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(all), 2)
+
+	// Only the known settings can be overridden
+	_, err = testQueries.SetContentEngagementOverride(context.Background(), SetContentEngagementOverrideParams{
+		ContentID: content.ContentID,
+		Setting:   "shares",
+		Enabled:   false,
+	})
+	require.Error(t, err)
+
+	deleted, err := testQueries.DeleteEngagementOverride(context.Background(), articleRule.ID)
+	require.NoError(t, err)
+	require.Equal(t, content.ContentID, deleted.ContentID)
+
+	articleRules, err = testQueries.ListContentEngagementOverrides(context.Background(), content.ContentID)
+	require.NoError(t, err)
+	require.Empty(t, articleRules)
+
+	_, err = testQueries.DeleteEngagementOverride(context.Background(), rule.ID)
+	require.NoError(t, err)
+}
@@ -26,7 +26,7 @@ func (q *Queries) CheckGlobalSettingsExists(ctx context.Context) (bool, error) {
 const createGlobalSettings = `-- name: CreateGlobalSettings :one
 INSERT INTO "global_settings" ("disable_comments", "disable_likes", "disable_dislikes", "disable_views", "disable_ads")
 VALUES (false, false, true, false, false)
-RETURNING global_settings_id, disable_comments, disable_likes, disable_dislikes, disable_views, disable_ads
+RETURNING global_settings_id, disable_comments, disable_likes, disable_dislikes, disable_views, disable_ads, watermark_enabled, watermark_logo, watermark_position, watermark_opacity, watermark_scale, comment_max_depth, comment_max_links, comment_blocklist, comment_edit_window, site_mode, site_mode_message
 `
 
 func (q *Queries) CreateGlobalSettings(ctx context.Context) (GlobalSetting, error) {
@@ -39,12 +39,23 @@ func (q *Queries) CreateGlobalSettings(ctx context.Context) (GlobalSetting, erro
 		&i.DisableDislikes,
 		&i.DisableViews,
 		&i.DisableAds,
+		&i.WatermarkEnabled,
+		&i.WatermarkLogo,
+		&i.WatermarkPosition,
+		&i.WatermarkOpacity,
+		&i.WatermarkScale,
+		&i.CommentMaxDepth,
+		&i.CommentMaxLinks,
+		&i.CommentBlocklist,
+		&i.CommentEditWindow,
+		&i.SiteMode,
+		&i.SiteModeMessage,
 	)
 	return i, err
 }
 
 const getGlobalSettings = `-- name: GetGlobalSettings :many
-SELECT global_settings_id, disable_comments, disable_likes, disable_dislikes, disable_views, disable_ads FROM "global_settings"
+SELECT global_settings_id, disable_comments, disable_likes, disable_dislikes, disable_views, disable_ads, watermark_enabled, watermark_logo, watermark_position, watermark_opacity, watermark_scale, comment_max_depth, comment_max_links, comment_blocklist, comment_edit_window, site_mode, site_mode_message FROM "global_settings"
 `
 
 func (q *Queries) GetGlobalSettings(ctx context.Context) ([]GlobalSetting, error) {
@@ -63,6 +74,17 @@ func (q *Queries) GetGlobalSettings(ctx context.Context) ([]GlobalSetting, error
 			&i.DisableDislikes,
 			&i.DisableViews,
 			&i.DisableAds,
+			&i.WatermarkEnabled,
+			&i.WatermarkLogo,
+			&i.WatermarkPosition,
+			&i.WatermarkOpacity,
+			&i.WatermarkScale,
+			&i.CommentMaxDepth,
+			&i.CommentMaxLinks,
+			&i.CommentBlocklist,
+			&i.CommentEditWindow,
+			&i.SiteMode,
+			&i.SiteModeMessage,
 		); err != nil {
 			return nil, err
 		}
@@ -90,6 +112,33 @@ func (q *Queries) ResetGlobalSettings(ctx context.Context) error {
 	return err
 }
 
+const updateCommentSettings = `-- name: UpdateCommentSettings :exec
+UPDATE "global_settings"
+SET
+    "comment_max_depth" = $1,
+    "comment_max_links" = $2,
+    "comment_blocklist" = $3,
+    "comment_edit_window" = $4
+WHERE "global_settings_id" = (SELECT "global_settings_id" FROM "global_settings" LIMIT 1)
+`
+
+type UpdateCommentSettingsParams struct {
+	CommentMaxDepth   int32
+	CommentMaxLinks   int32
+	CommentBlocklist  string
+	CommentEditWindow int32
+}
+
+func (q *Queries) UpdateCommentSettings(ctx context.Context, arg UpdateCommentSettingsParams) error {
+	_, err := q.db.Exec(ctx, updateCommentSettings,
+		arg.CommentMaxDepth,
+		arg.CommentMaxLinks,
+		arg.CommentBlocklist,
+		arg.CommentEditWindow,
+	)
+	return err
+}
+
 const updateGlobalSettings = `-- name: UpdateGlobalSettings :exec
 UPDATE "global_settings"
 SET
@@ -119,3 +168,51 @@ func (q *Queries) UpdateGlobalSettings(ctx context.Context, arg UpdateGlobalSett
 	)
 	return err
 }
+
+const updateSiteMode = `-- name: UpdateSiteMode :exec
+UPDATE "global_settings"
+SET
+    "site_mode" = $1,
+    "site_mode_message" = $2
+WHERE "global_settings_id" = (SELECT "global_settings_id" FROM "global_settings" LIMIT 1)
+`
+
+type UpdateSiteModeParams struct {
+	SiteMode        string
+	SiteModeMessage string
+}
+
+func (q *Queries) UpdateSiteMode(ctx context.Context, arg UpdateSiteModeParams) error {
+	_, err := q.db.Exec(ctx, updateSiteMode, arg.SiteMode, arg.SiteModeMessage)
+	return err
+}
+
+const updateWatermarkSettings = `-- name: UpdateWatermarkSettings :exec
+UPDATE "global_settings"
+SET
+    "watermark_enabled" = $1,
+    "watermark_logo" = $2,
+    "watermark_position" = $3,
+    "watermark_opacity" = $4,
+    "watermark_scale" = $5
+WHERE "global_settings_id" = (SELECT "global_settings_id" FROM "global_settings" LIMIT 1)
+`
+
+type UpdateWatermarkSettingsParams struct {
+	WatermarkEnabled  bool
+	WatermarkLogo     string
+	WatermarkPosition string
+	WatermarkOpacity  float32
+	WatermarkScale    float32
+}
+
+func (q *Queries) UpdateWatermarkSettings(ctx context.Context, arg UpdateWatermarkSettingsParams) error {
+	_, err := q.db.Exec(ctx, updateWatermarkSettings,
+		arg.WatermarkEnabled,
+		arg.WatermarkLogo,
+		arg.WatermarkPosition,
+		arg.WatermarkOpacity,
+		arg.WatermarkScale,
+	)
+	return err
+}
@@ -64,3 +64,73 @@ func TestResetGlobalSettings(t *testing.T) {
 	require.Equal(t, false, globalSettings[0].DisableViews)
 	require.Equal(t, false, globalSettings[0].DisableAds)
 }
+
+func TestUpdateWatermarkSettings(t *testing.T) {
+	arg := UpdateWatermarkSettingsParams{
+		WatermarkEnabled:  true,
+		WatermarkLogo:     "/static/assets/macva-1-300x71.png",
+		WatermarkPosition: "top-left",
+		WatermarkOpacity:  0.8,
+		WatermarkScale:    0.3,
+	}
+
+	err := testQueries.UpdateWatermarkSettings(context.Background(), arg)
+	require.NoError(t, err)
+
+	globalSettings, err := testQueries.GetGlobalSettings(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, arg.WatermarkEnabled, globalSettings[0].WatermarkEnabled)
+	require.Equal(t, arg.WatermarkLogo, globalSettings[0].WatermarkLogo)
+	require.Equal(t, arg.WatermarkPosition, globalSettings[0].WatermarkPosition)
+	require.Equal(t, arg.WatermarkOpacity, globalSettings[0].WatermarkOpacity)
+	require.Equal(t, arg.WatermarkScale, globalSettings[0].WatermarkScale)
+}
+
+func TestUpdateCommentSettings(t *testing.T) {
+	err := testQueries.UpdateCommentSettings(context.Background(), UpdateCommentSettingsParams{
+		CommentMaxDepth:   6,
+		CommentMaxLinks:   3,
+		CommentBlocklist:  "kazino\nбрза зарада",
+		CommentEditWindow: 30,
+	})
+	require.NoError(t, err)
+
+	globalSettings, err := testQueries.GetGlobalSettings(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int32(6), globalSettings[0].CommentMaxDepth)
+	require.Equal(t, int32(3), globalSettings[0].CommentMaxLinks)
+	require.Equal(t, "kazino\nбрза зарада", globalSettings[0].CommentBlocklist)
+	require.Equal(t, int32(30), globalSettings[0].CommentEditWindow)
+
+	// Back to the defaults
+	err = testQueries.UpdateCommentSettings(context.Background(), UpdateCommentSettingsParams{
+		CommentMaxDepth:   4,
+		CommentMaxLinks:   2,
+		CommentEditWindow: 15,
+	})
+	require.NoError(t, err)
+}
+
+func TestUpdateSiteMode(t *testing.T) {
+	arg := UpdateSiteModeParams{
+		SiteMode:        "read_only",
+		SiteModeMessage: "Održavanje baze do 22h",
+	}
+
+	err := testQueries.UpdateSiteMode(context.Background(), arg)
+	require.NoError(t, err)
+
+	globalSettings, err := testQueries.GetGlobalSettings(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, arg.SiteMode, globalSettings[0].SiteMode)
+	require.Equal(t, arg.SiteModeMessage, globalSettings[0].SiteModeMessage)
+
+	// Only the known modes can be set
+	err = testQueries.UpdateSiteMode(context.Background(), UpdateSiteModeParams{SiteMode: "closed"})
+	require.Error(t, err)
+
+	err = testQueries.UpdateSiteMode(context.Background(), UpdateSiteModeParams{SiteMode: "normal"})
+	require.NoError(t, err)
+}
@@ -33,6 +33,7 @@ func TestMain(m *testing.M) {
 	log.Println("Connected to db.")
 
 	testQueries = New(conn)
+	testDB = conn
 
 	os.Exit(m.Run())
 }
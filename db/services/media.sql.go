@@ -41,6 +41,19 @@ func (q *Queries) BatchUpdateMediaOrder(ctx context.Context, arg BatchUpdateMedi
 	return err
 }
 
+const countMediaByUrl = `-- name: CountMediaByUrl :one
+SELECT COUNT(*)
+FROM media
+WHERE media_url = $1
+`
+
+func (q *Queries) CountMediaByUrl(ctx context.Context, mediaUrl string) (int64, error) {
+	row := q.db.QueryRow(ctx, countMediaByUrl, mediaUrl)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const deleteMedia = `-- name: DeleteMedia :exec
 DELETE FROM media
 WHERE media_id = $1
@@ -52,7 +65,7 @@ func (q *Queries) DeleteMedia(ctx context.Context, mediaID pgtype.UUID) error {
 }
 
 const getMediaByID = `-- name: GetMediaByID :one
-SELECT media_id, content_id, media_type, media_url, media_caption, media_order
+SELECT media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
 FROM media
 WHERE media_id = $1
 `
@@ -67,14 +80,47 @@ func (q *Queries) GetMediaByID(ctx context.Context, mediaID pgtype.UUID) (Medium
 		&i.MediaUrl,
 		&i.MediaCaption,
 		&i.MediaOrder,
+		&i.MediaCredit,
+		&i.FocalX,
+		&i.FocalY,
+		&i.Phash,
+		&i.OriginalPath,
+		&i.Watermark,
+	)
+	return i, err
+}
+
+const getMediaByUrl = `-- name: GetMediaByUrl :one
+SELECT media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
+FROM media
+WHERE media_url = $1
+LIMIT 1
+`
+
+func (q *Queries) GetMediaByUrl(ctx context.Context, mediaUrl string) (Medium, error) {
+	row := q.db.QueryRow(ctx, getMediaByUrl, mediaUrl)
+	var i Medium
+	err := row.Scan(
+		&i.MediaID,
+		&i.ContentID,
+		&i.MediaType,
+		&i.MediaUrl,
+		&i.MediaCaption,
+		&i.MediaOrder,
+		&i.MediaCredit,
+		&i.FocalX,
+		&i.FocalY,
+		&i.Phash,
+		&i.OriginalPath,
+		&i.Watermark,
 	)
 	return i, err
 }
 
 const insertMedia = `-- name: InsertMedia :one
-INSERT INTO media (content_id, media_type, media_url, media_caption, media_order)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING media_id, content_id, media_type, media_url, media_caption, media_order
+INSERT INTO media (content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
 `
 
 type InsertMediaParams struct {
@@ -83,6 +129,12 @@ type InsertMediaParams struct {
 	MediaUrl     string
 	MediaCaption string
 	MediaOrder   int32
+	MediaCredit  string
+	FocalX       float32
+	FocalY       float32
+	Phash        pgtype.Int8
+	OriginalPath string
+	Watermark    bool
 }
 
 func (q *Queries) InsertMedia(ctx context.Context, arg InsertMediaParams) (Medium, error) {
@@ -92,6 +144,12 @@ func (q *Queries) InsertMedia(ctx context.Context, arg InsertMediaParams) (Mediu
 		arg.MediaUrl,
 		arg.MediaCaption,
 		arg.MediaOrder,
+		arg.MediaCredit,
+		arg.FocalX,
+		arg.FocalY,
+		arg.Phash,
+		arg.OriginalPath,
+		arg.Watermark,
 	)
 	var i Medium
 	err := row.Scan(
@@ -101,12 +159,101 @@ func (q *Queries) InsertMedia(ctx context.Context, arg InsertMediaParams) (Mediu
 		&i.MediaUrl,
 		&i.MediaCaption,
 		&i.MediaOrder,
+		&i.MediaCredit,
+		&i.FocalX,
+		&i.FocalY,
+		&i.Phash,
+		&i.OriginalPath,
+		&i.Watermark,
 	)
 	return i, err
 }
 
+const listContentIDsByMediaUrl = `-- name: ListContentIDsByMediaUrl :many
+SELECT content_id
+FROM media
+WHERE media_url = $1
+UNION
+SELECT content_id
+FROM content
+WHERE thumbnail = $1
+`
+
+func (q *Queries) ListContentIDsByMediaUrl(ctx context.Context, mediaUrl string) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listContentIDsByMediaUrl, mediaUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var content_id pgtype.UUID
+		if err := rows.Scan(&content_id); err != nil {
+			return nil, err
+		}
+		items = append(items, content_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHashedMedia = `-- name: ListHashedMedia :many
+SELECT
+  m.media_id,
+  m.content_id,
+  m.media_url,
+  m.focal_x,
+  m.focal_y,
+  m.phash,
+  c.title
+FROM media m
+JOIN content c ON m.content_id = c.content_id
+WHERE m.phash IS NOT NULL
+ORDER BY m.media_url
+`
+
+type ListHashedMediaRow struct {
+	MediaID   pgtype.UUID
+	ContentID pgtype.UUID
+	MediaUrl  string
+	FocalX    float32
+	FocalY    float32
+	Phash     pgtype.Int8
+	Title     string
+}
+
+func (q *Queries) ListHashedMedia(ctx context.Context) ([]ListHashedMediaRow, error) {
+	rows, err := q.db.Query(ctx, listHashedMedia)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHashedMediaRow
+	for rows.Next() {
+		var i ListHashedMediaRow
+		if err := rows.Scan(
+			&i.MediaID,
+			&i.ContentID,
+			&i.MediaUrl,
+			&i.FocalX,
+			&i.FocalY,
+			&i.Phash,
+			&i.Title,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMediaForContent = `-- name: ListMediaForContent :many
-SELECT media_id, content_id, media_type, media_url, media_caption, media_order
+SELECT media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
 FROM media
 WHERE content_id = $1
 ORDER BY media_order ASC
@@ -128,6 +275,50 @@ func (q *Queries) ListMediaForContent(ctx context.Context, contentID pgtype.UUID
 			&i.MediaUrl,
 			&i.MediaCaption,
 			&i.MediaOrder,
+			&i.MediaCredit,
+			&i.FocalX,
+			&i.FocalY,
+			&i.Phash,
+			&i.OriginalPath,
+			&i.Watermark,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMediaWithOriginals = `-- name: ListMediaWithOriginals :many
+SELECT media_id, media_url, original_path, watermark
+FROM media
+WHERE original_path <> ''
+`
+
+type ListMediaWithOriginalsRow struct {
+	MediaID      pgtype.UUID
+	MediaUrl     string
+	OriginalPath string
+	Watermark    bool
+}
+
+func (q *Queries) ListMediaWithOriginals(ctx context.Context) ([]ListMediaWithOriginalsRow, error) {
+	rows, err := q.db.Query(ctx, listMediaWithOriginals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMediaWithOriginalsRow
+	for rows.Next() {
+		var i ListMediaWithOriginalsRow
+		if err := rows.Scan(
+			&i.MediaID,
+			&i.MediaUrl,
+			&i.OriginalPath,
+			&i.Watermark,
 		); err != nil {
 			return nil, err
 		}
@@ -139,13 +330,164 @@ func (q *Queries) ListMediaForContent(ctx context.Context, contentID pgtype.UUID
 	return items, nil
 }
 
+const listSimilarMedia = `-- name: ListSimilarMedia :many
+SELECT
+  m.media_id,
+  m.content_id,
+  m.media_url,
+  m.media_caption,
+  m.focal_x,
+  m.focal_y,
+  c.title,
+  bit_count((m.phash # $1::bigint)::bit(64))::int AS distance
+FROM media m
+JOIN content c ON m.content_id = c.content_id
+WHERE m.phash IS NOT NULL
+  AND m.media_url <> $2
+  AND bit_count((m.phash # $1::bigint)::bit(64)) <= $3::int
+ORDER BY distance ASC
+LIMIT $4
+`
+
+type ListSimilarMediaParams struct {
+	Phash       int64
+	MediaUrl    string
+	MaxDistance int32
+	MaxResults  int32
+}
+
+type ListSimilarMediaRow struct {
+	MediaID      pgtype.UUID
+	ContentID    pgtype.UUID
+	MediaUrl     string
+	MediaCaption string
+	FocalX       float32
+	FocalY       float32
+	Title        string
+	Distance     int32
+}
+
+func (q *Queries) ListSimilarMedia(ctx context.Context, arg ListSimilarMediaParams) ([]ListSimilarMediaRow, error) {
+	rows, err := q.db.Query(ctx, listSimilarMedia,
+		arg.Phash,
+		arg.MediaUrl,
+		arg.MaxDistance,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSimilarMediaRow
+	for rows.Next() {
+		var i ListSimilarMediaRow
+		if err := rows.Scan(
+			&i.MediaID,
+			&i.ContentID,
+			&i.MediaUrl,
+			&i.MediaCaption,
+			&i.FocalX,
+			&i.FocalY,
+			&i.Title,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnhashedImages = `-- name: ListUnhashedImages :many
+SELECT media_id, media_url
+FROM media
+WHERE phash IS NULL
+  AND media_type = 'image'
+  AND NOT EXISTS (
+    SELECT 1 FROM media_hash_failure f WHERE f.media_id = media.media_id
+  )
+`
+
+type ListUnhashedImagesRow struct {
+	MediaID  pgtype.UUID
+	MediaUrl string
+}
+
+func (q *Queries) ListUnhashedImages(ctx context.Context) ([]ListUnhashedImagesRow, error) {
+	rows, err := q.db.Query(ctx, listUnhashedImages)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnhashedImagesRow
+	for rows.Next() {
+		var i ListUnhashedImagesRow
+		if err := rows.Scan(&i.MediaID, &i.MediaUrl); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markMediaHashFailed = `-- name: MarkMediaHashFailed :exec
+INSERT INTO media_hash_failure (media_id)
+VALUES ($1)
+ON CONFLICT (media_id) DO NOTHING
+`
+
+func (q *Queries) MarkMediaHashFailed(ctx context.Context, mediaID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markMediaHashFailed, mediaID)
+	return err
+}
+
+const replaceMediaUrl = `-- name: ReplaceMediaUrl :exec
+UPDATE media
+SET media_url = $1,
+    focal_x = $2,
+    focal_y = $3,
+    phash = $4,
+    original_path = $5,
+    watermark = $6
+WHERE media_url = $7
+`
+
+type ReplaceMediaUrlParams struct {
+	NewUrl       string
+	FocalX       float32
+	FocalY       float32
+	Phash        pgtype.Int8
+	OriginalPath string
+	Watermark    bool
+	OldUrl       string
+}
+
+func (q *Queries) ReplaceMediaUrl(ctx context.Context, arg ReplaceMediaUrlParams) error {
+	_, err := q.db.Exec(ctx, replaceMediaUrl,
+		arg.NewUrl,
+		arg.FocalX,
+		arg.FocalY,
+		arg.Phash,
+		arg.OriginalPath,
+		arg.Watermark,
+		arg.OldUrl,
+	)
+	return err
+}
+
 const updateMedia = `-- name: UpdateMedia :one
 UPDATE media
 SET media_url = $1,
     media_caption = $2,
     media_order = $3
 WHERE media_id = $4
-RETURNING media_id, content_id, media_type, media_url, media_caption, media_order
+RETURNING media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
 `
 
 type UpdateMediaParams struct {
@@ -170,6 +512,96 @@ func (q *Queries) UpdateMedia(ctx context.Context, arg UpdateMediaParams) (Mediu
 		&i.MediaUrl,
 		&i.MediaCaption,
 		&i.MediaOrder,
+		&i.MediaCredit,
+		&i.FocalX,
+		&i.FocalY,
+		&i.Phash,
+		&i.OriginalPath,
+		&i.Watermark,
 	)
 	return i, err
 }
+
+const updateMediaCaption = `-- name: UpdateMediaCaption :one
+UPDATE media
+SET media_caption = $1,
+    media_credit = $2
+WHERE media_id = $3
+RETURNING media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
+`
+
+type UpdateMediaCaptionParams struct {
+	MediaCaption string
+	MediaCredit  string
+	MediaID      pgtype.UUID
+}
+
+func (q *Queries) UpdateMediaCaption(ctx context.Context, arg UpdateMediaCaptionParams) (Medium, error) {
+	row := q.db.QueryRow(ctx, updateMediaCaption, arg.MediaCaption, arg.MediaCredit, arg.MediaID)
+	var i Medium
+	err := row.Scan(
+		&i.MediaID,
+		&i.ContentID,
+		&i.MediaType,
+		&i.MediaUrl,
+		&i.MediaCaption,
+		&i.MediaOrder,
+		&i.MediaCredit,
+		&i.FocalX,
+		&i.FocalY,
+		&i.Phash,
+		&i.OriginalPath,
+		&i.Watermark,
+	)
+	return i, err
+}
+
+const updateMediaFocalPoint = `-- name: UpdateMediaFocalPoint :one
+UPDATE media
+SET focal_x = $1,
+    focal_y = $2
+WHERE media_id = $3
+RETURNING media_id, content_id, media_type, media_url, media_caption, media_order, media_credit, focal_x, focal_y, phash, original_path, watermark
+`
+
+type UpdateMediaFocalPointParams struct {
+	FocalX  float32
+	FocalY  float32
+	MediaID pgtype.UUID
+}
+
+func (q *Queries) UpdateMediaFocalPoint(ctx context.Context, arg UpdateMediaFocalPointParams) (Medium, error) {
+	row := q.db.QueryRow(ctx, updateMediaFocalPoint, arg.FocalX, arg.FocalY, arg.MediaID)
+	var i Medium
+	err := row.Scan(
+		&i.MediaID,
+		&i.ContentID,
+		&i.MediaType,
+		&i.MediaUrl,
+		&i.MediaCaption,
+		&i.MediaOrder,
+		&i.MediaCredit,
+		&i.FocalX,
+		&i.FocalY,
+		&i.Phash,
+		&i.OriginalPath,
+		&i.Watermark,
+	)
+	return i, err
+}
+
+const updateMediaPhash = `-- name: UpdateMediaPhash :exec
+UPDATE media
+SET phash = $1
+WHERE media_id = $2
+`
+
+type UpdateMediaPhashParams struct {
+	Phash   pgtype.Int8
+	MediaID pgtype.UUID
+}
+
+func (q *Queries) UpdateMediaPhash(ctx context.Context, arg UpdateMediaPhashParams) error {
+	_, err := q.db.Exec(ctx, updateMediaPhash, arg.Phash, arg.MediaID)
+	return err
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
 
@@ -80,6 +81,139 @@ func TestUpdateMedia(t *testing.T) {
 	require.Equal(t, "Specific Image", updatedMedia.MediaCaption)
 }
 
+func TestUpdateMediaCaption(t *testing.T) {
+	media := createMedia(t)
+	require.Empty(t, media[0].MediaCredit)
+
+	updatedMedia, err := testQueries.UpdateMediaCaption(context.Background(), UpdateMediaCaptionParams{
+		MediaCaption: "Pre-filled caption",
+		MediaCredit:  "Pera Perić",
+		MediaID:      media[0].MediaID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, media[0].MediaID, updatedMedia.MediaID)
+	require.Equal(t, media[0].MediaUrl, updatedMedia.MediaUrl)
+	require.Equal(t, "Pre-filled caption", updatedMedia.MediaCaption)
+	require.Equal(t, "Pera Perić", updatedMedia.MediaCredit)
+}
+
+func TestUpdateMediaFocalPoint(t *testing.T) {
+	media := createMedia(t)
+
+	updatedMedia, err := testQueries.UpdateMediaFocalPoint(context.Background(), UpdateMediaFocalPointParams{
+		FocalX:  0.25,
+		FocalY:  0.75,
+		MediaID: media[0].MediaID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, media[0].MediaID, updatedMedia.MediaID)
+	require.Equal(t, float32(0.25), updatedMedia.FocalX)
+	require.Equal(t, float32(0.75), updatedMedia.FocalY)
+}
+
+func TestListSimilarMedia(t *testing.T) {
+	media := createMedia(t)
+	hash := utils.RandomInt(1, 1<<40)
+
+	// Same photo uploaded again, a couple of bits off after re-encoding
+	for i, medium := range media[:2] {
+		err := testQueries.UpdateMediaPhash(context.Background(), UpdateMediaPhashParams{
+			Phash:   pgtype.Int8{Int64: hash ^ int64(i<<3), Valid: true},
+			MediaID: medium.MediaID,
+		})
+		require.NoError(t, err)
+	}
+
+	similar, err := testQueries.ListSimilarMedia(context.Background(), ListSimilarMediaParams{
+		Phash:       hash,
+		MediaUrl:    media[0].MediaUrl,
+		MaxDistance: 6,
+		MaxResults:  10,
+	})
+	require.NoError(t, err)
+
+	var found bool
+	for _, s := range similar {
+		require.NotEqual(t, media[0].MediaUrl, s.MediaUrl)
+		require.LessOrEqual(t, s.Distance, int32(6))
+		if s.MediaID == media[1].MediaID {
+			found = true
+			require.Equal(t, int32(1), s.Distance)
+		}
+	}
+	require.True(t, found)
+}
+
+func TestReplaceMediaUrl(t *testing.T) {
+	media := createMedia(t)
+
+	count, err := testQueries.CountMediaByUrl(context.Background(), media[1].MediaUrl)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	err = testQueries.ReplaceMediaUrl(context.Background(), ReplaceMediaUrlParams{
+		NewUrl:       media[0].MediaUrl,
+		FocalX:       0.2,
+		FocalY:       0.8,
+		Phash:        media[0].Phash,
+		OriginalPath: "originals/kept.jpg",
+		Watermark:    true,
+		OldUrl:       media[1].MediaUrl,
+	})
+	require.NoError(t, err)
+
+	count, err = testQueries.CountMediaByUrl(context.Background(), media[1].MediaUrl)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	reused, err := testQueries.GetMediaByID(context.Background(), media[1].MediaID)
+	require.NoError(t, err)
+	require.Equal(t, media[0].MediaUrl, reused.MediaUrl)
+	require.Equal(t, "originals/kept.jpg", reused.OriginalPath)
+	require.True(t, reused.Watermark)
+	require.Equal(t, float32(0.2), reused.FocalX)
+	require.Equal(t, float32(0.8), reused.FocalY)
+}
+
+func TestListContentIDsByMediaUrl(t *testing.T) {
+	media := createMedia(t)
+	other := createRandomContent(t)
+
+	_, err := testQueries.AddThumbnail(context.Background(), AddThumbnailParams{
+		ContentID: other.ContentID,
+		Thumbnail: pgtype.Text{String: media[0].MediaUrl, Valid: true},
+	})
+	require.NoError(t, err)
+
+	contentIDs, err := testQueries.ListContentIDsByMediaUrl(context.Background(), media[0].MediaUrl)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []pgtype.UUID{media[0].ContentID, other.ContentID}, contentIDs)
+}
+
+func TestMarkMediaHashFailed(t *testing.T) {
+	media := createMedia(t)
+
+	unhashed := func() bool {
+		images, err := testQueries.ListUnhashedImages(context.Background())
+		require.NoError(t, err)
+		for _, image := range images {
+			if image.MediaID == media[0].MediaID {
+				return true
+			}
+		}
+		return false
+	}
+	require.True(t, unhashed())
+
+	err := testQueries.MarkMediaHashFailed(context.Background(), media[0].MediaID)
+	require.NoError(t, err)
+	require.False(t, unhashed())
+
+	// Marking twice is a no-op
+	err = testQueries.MarkMediaHashFailed(context.Background(), media[0].MediaID)
+	require.NoError(t, err)
+}
+
 // this one tests both the ListMediaForContent and DeleteMedia
 func TestDeleteMedia(t *testing.T) {
 	content := createRandomContent(t)
@@ -9,18 +9,62 @@ import (
 )
 
 type Ad struct {
-	ID          pgtype.UUID
-	Title       pgtype.Text
-	Description pgtype.Text
-	ImageUrl    pgtype.Text
-	TargetUrl   pgtype.Text
-	Placement   pgtype.Text
-	Status      pgtype.Text
-	Clicks      pgtype.Int4
-	StartDate   pgtype.Timestamptz
-	EndDate     pgtype.Timestamptz
-	CreatedAt   pgtype.Timestamptz
-	UpdatedAt   pgtype.Timestamptz
+	ID             pgtype.UUID
+	Title          pgtype.Text
+	Description    pgtype.Text
+	ImageUrl       pgtype.Text
+	TargetUrl      pgtype.Text
+	Placement      pgtype.Text
+	Status         pgtype.Text
+	Clicks         pgtype.Int4
+	StartDate      pgtype.Timestamptz
+	EndDate        pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	Weight         int32
+	FrequencyCap   int32
+	ImpressionGoal int32
+	IsHouse        bool
+	State          string
+	StateChangedAt pgtype.Timestamptz
+}
+
+type AdAdvertiser struct {
+	AdID      pgtype.UUID
+	UserID    pgtype.UUID
+	CreatedAt pgtype.Timestamptz
+}
+
+type AdPlacement struct {
+	Key       string
+	Name      string
+	Width     int32
+	Height    int32
+	Formats   []string
+	PageTypes []string
+	IsActive  bool
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type AdStat struct {
+	AdID        pgtype.UUID
+	Placement   string
+	StatDate    pgtype.Date
+	Impressions int32
+	Clicks      int32
+}
+
+type AdTargeting struct {
+	AdID              pgtype.UUID
+	TargetCategories  []pgtype.UUID
+	ExcludeCategories []pgtype.UUID
+	TargetTags        []pgtype.UUID
+	ExcludeTags       []pgtype.UUID
+	TargetPageTypes   []string
+	ExcludePageTypes  []string
+	DaypartStart      int32
+	DaypartEnd        int32
 }
 
 type AnalyticsDaily struct {
@@ -50,6 +94,9 @@ type Comment struct {
 	UpdatedAt       pgtype.Timestamptz
 	IsDeleted       pgtype.Bool
 	ParentCommentID pgtype.UUID
+	IsHeld          bool
+	HeldReason      string
+	IsShadowed      bool
 }
 
 type CommentReaction struct {
@@ -58,6 +105,13 @@ type CommentReaction struct {
 	Reaction  string
 }
 
+type CommentRevision struct {
+	RevisionID  pgtype.UUID
+	CommentID   pgtype.UUID
+	CommentText string
+	CreatedAt   pgtype.Timestamptz
+}
+
 type Content struct {
 	ContentID           pgtype.UUID
 	UserID              pgtype.UUID
@@ -79,6 +133,11 @@ type Content struct {
 	UpdatedAt           pgtype.Timestamptz
 	PublishedAt         pgtype.Timestamptz
 	IsDeleted           pgtype.Bool
+	ThumbnailFocalX     float32
+	ThumbnailFocalY     float32
+	IsSponsored         bool
+	SponsorName         pgtype.Text
+	SponsorLogo         pgtype.Text
 }
 
 type ContentReaction struct {
@@ -92,13 +151,34 @@ type ContentTag struct {
 	TagID     pgtype.UUID
 }
 
+type EngagementOverride struct {
+	ID         pgtype.UUID
+	CategoryID pgtype.UUID
+	ContentID  pgtype.UUID
+	Setting    string
+	Enabled    bool
+	AfterHours pgtype.Int4
+	CreatedAt  pgtype.Timestamptz
+}
+
 type GlobalSetting struct {
-	GlobalSettingsID pgtype.UUID
-	DisableComments  bool
-	DisableLikes     bool
-	DisableDislikes  bool
-	DisableViews     bool
-	DisableAds       bool
+	GlobalSettingsID  pgtype.UUID
+	DisableComments   bool
+	DisableLikes      bool
+	DisableDislikes   bool
+	DisableViews      bool
+	DisableAds        bool
+	WatermarkEnabled  bool
+	WatermarkLogo     string
+	WatermarkPosition string
+	WatermarkOpacity  float32
+	WatermarkScale    float32
+	CommentMaxDepth   int32
+	CommentMaxLinks   int32
+	CommentBlocklist  string
+	CommentEditWindow int32
+	SiteMode          string
+	SiteModeMessage   string
 }
 
 type Medium struct {
@@ -108,6 +188,33 @@ type Medium struct {
 	MediaUrl     string
 	MediaCaption string
 	MediaOrder   int32
+	MediaCredit  string
+	FocalX       float32
+	FocalY       float32
+	Phash        pgtype.Int8
+	OriginalPath string
+	Watermark    bool
+}
+
+type MediaHashFailure struct {
+	MediaID  pgtype.UUID
+	FailedAt pgtype.Timestamptz
+}
+
+type Notification struct {
+	NotificationID pgtype.UUID
+	UserID         pgtype.UUID
+	ActorID        pgtype.UUID
+	CommentID      pgtype.UUID
+	Kind           string
+	IsRead         bool
+	CreatedAt      pgtype.Timestamptz
+}
+
+type NotificationSetting struct {
+	UserID        pgtype.UUID
+	EmailReplies  bool
+	EmailMentions bool
 }
 
 type Session struct {
@@ -122,6 +229,24 @@ type Session struct {
 	CreatedAt    pgtype.Timestamptz
 }
 
+type SpamToken struct {
+	Token     string
+	SpamCount int32
+	HamCount  int32
+}
+
+type SpamTraining struct {
+	CommentID pgtype.UUID
+	IsSpam    bool
+	CreatedAt pgtype.Timestamptz
+}
+
+type SponsoredStat struct {
+	ContentID pgtype.UUID
+	StatDate  pgtype.Date
+	Views     int32
+}
+
 type Tag struct {
 	TagID   pgtype.UUID
 	TagName string
@@ -141,6 +266,17 @@ type User struct {
 	CreatedAt     pgtype.Timestamptz
 }
 
+type UserSanction struct {
+	SanctionID pgtype.UUID
+	UserID     pgtype.UUID
+	Kind       string
+	Reason     string
+	ExpiresAt  pgtype.Timestamptz
+	CreatedBy  pgtype.UUID
+	CreatedAt  pgtype.Timestamptz
+	LiftedAt   pgtype.Timestamptz
+}
+
 type View struct {
 	ViewID    pgtype.UUID
 	ContentID pgtype.UUID
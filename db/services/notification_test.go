@@ -0,0 +1,159 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateNotification(t *testing.T) {
+	comment := createRandomComment(t)
+	user := createRandomUser(t)
+
+	arg := CreateNotificationParams{
+		UserID:    user.UserID,
+		ActorID:   comment.UserID,
+		CommentID: comment.CommentID,
+		Kind:      "reply",
+	}
+
+	created, err := testQueries.CreateNotification(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), created)
+
+	// A comment notifies each reader once
+	arg.Kind = "mention"
+	created, err = testQueries.CreateNotification(context.Background(), arg)
+	require.NoError(t, err)
+	require.Zero(t, created)
+
+	count, err := testQueries.CountUnreadNotifications(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	notifications, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{
+		UserID: user.UserID,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	require.Equal(t, comment.CommentID, notifications[0].CommentID)
+	require.Equal(t, "reply", notifications[0].Kind)
+	require.False(t, notifications[0].IsRead)
+	require.NotEmpty(t, notifications[0].ActorUsername)
+	require.NotEmpty(t, notifications[0].ContentTitle)
+
+	commentID, err := testQueries.MarkNotificationRead(context.Background(), MarkNotificationReadParams{
+		NotificationID: notifications[0].NotificationID,
+		UserID:         user.UserID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, comment.CommentID, commentID)
+
+	count, err = testQueries.CountUnreadNotifications(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestMarkNotificationReadOtherUser(t *testing.T) {
+	comment := createRandomComment(t)
+	user := createRandomUser(t)
+	other := createRandomUser(t)
+
+	_, err := testQueries.CreateNotification(context.Background(), CreateNotificationParams{
+		UserID:    user.UserID,
+		ActorID:   comment.UserID,
+		CommentID: comment.CommentID,
+		Kind:      "mention",
+	})
+	require.NoError(t, err)
+
+	notifications, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{
+		UserID: user.UserID,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+
+	_, err = testQueries.MarkNotificationRead(context.Background(), MarkNotificationReadParams{
+		NotificationID: notifications[0].NotificationID,
+		UserID:         other.UserID,
+	})
+	require.Error(t, err)
+
+	err = testQueries.MarkAllNotificationsRead(context.Background(), user.UserID)
+	require.NoError(t, err)
+
+	count, err := testQueries.CountUnreadNotifications(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestGetNotificationComment(t *testing.T) {
+	comment := createRandomComment(t)
+	user := createRandomUser(t)
+
+	_, err := testQueries.CreateNotification(context.Background(), CreateNotificationParams{
+		UserID:    user.UserID,
+		ActorID:   comment.UserID,
+		CommentID: comment.CommentID,
+		Kind:      "reply",
+	})
+	require.NoError(t, err)
+
+	notifications, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{
+		UserID: user.UserID,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+
+	commentID, err := testQueries.GetNotificationComment(context.Background(), GetNotificationCommentParams{
+		NotificationID: notifications[0].NotificationID,
+		UserID:         user.UserID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, comment.CommentID, commentID)
+
+	// Reading it leaves it unread
+	count, err := testQueries.CountUnreadNotifications(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestNotificationSettings(t *testing.T) {
+	user := createRandomUser(t)
+
+	// Everything is emailed until the reader changes it
+	settings, err := testQueries.GetNotificationSettings(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.True(t, settings.EmailReplies)
+	require.True(t, settings.EmailMentions)
+
+	err = testQueries.UpsertNotificationSettings(context.Background(), UpsertNotificationSettingsParams{
+		UserID:        user.UserID,
+		EmailReplies:  true,
+		EmailMentions: false,
+	})
+	require.NoError(t, err)
+
+	settings, err = testQueries.GetNotificationSettings(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.True(t, settings.EmailReplies)
+	require.False(t, settings.EmailMentions)
+}
+
+func TestGetUsersByUsernames(t *testing.T) {
+	user1 := createRandomUser(t)
+	user2 := createRandomUser(t)
+
+	users, err := testQueries.GetUsersByUsernames(context.Background(), []string{
+		strings.ToLower(user1.Username),
+		strings.ToLower(user2.Username),
+		"nonexistent-user",
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/00mark0/macva-press/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrainSpamTokens(t *testing.T) {
+	comment := createRandomComment(t)
+	token := "spamtoken" + utils.RandomString(8)
+
+	before, err := testQueries.GetSpamTrainingCounts(context.Background())
+	require.NoError(t, err)
+
+	created, err := testQueries.CreateSpamTraining(context.Background(), CreateSpamTrainingParams{
+		CommentID: comment.CommentID,
+		IsSpam:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), created)
+
+	// Each comment is learned once
+	created, err = testQueries.CreateSpamTraining(context.Background(), CreateSpamTrainingParams{
+		CommentID: comment.CommentID,
+		IsSpam:    false,
+	})
+	require.NoError(t, err)
+	require.Zero(t, created)
+
+	after, err := testQueries.GetSpamTrainingCounts(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, before.SpamCount+1, after.SpamCount)
+	require.Equal(t, before.HamCount, after.HamCount)
+
+	err = testQueries.TrainSpamTokens(context.Background(), TrainSpamTokensParams{IsSpam: true, Tokens: []string{token}})
+	require.NoError(t, err)
+	err = testQueries.TrainSpamTokens(context.Background(), TrainSpamTokensParams{IsSpam: true, Tokens: []string{token}})
+	require.NoError(t, err)
+	err = testQueries.TrainSpamTokens(context.Background(), TrainSpamTokensParams{IsSpam: false, Tokens: []string{token}})
+	require.NoError(t, err)
+
+	tokens, err := testQueries.GetSpamTokens(context.Background(), []string{token, "missing" + token})
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, int32(2), tokens[0].SpamCount)
+	require.Equal(t, int32(1), tokens[0].HamCount)
+}
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateContentSponsor(t *testing.T) {
+	content := createRandomContent(t)
+
+	published, err := testQueries.PublishContent(context.Background(), content.ContentID)
+	require.NoError(t, err)
+
+	err = testQueries.UpdateContentSponsor(context.Background(), UpdateContentSponsorParams{
+		ContentID:   content.ContentID,
+		IsSponsored: true,
+		SponsorName: pgtype.Text{String: "Sponzor d.o.o.", Valid: true},
+		SponsorLogo: pgtype.Text{String: "/static/uploads/sponzor.webp", Valid: true},
+	})
+	require.NoError(t, err)
+
+	details, err := testQueries.GetContentDetails(context.Background(), content.ContentID)
+	require.NoError(t, err)
+	require.True(t, details.IsSponsored)
+	require.Equal(t, "Sponzor d.o.o.", details.SponsorName.String)
+	require.Equal(t, "/static/uploads/sponzor.webp", details.SponsorLogo.String)
+
+	trending, err := testQueries.ListTrendingContent(context.Background(), ListTrendingContentParams{PublishedAt: published.PublishedAt, Limit: 1000})
+	require.NoError(t, err)
+	for _, v := range trending {
+		require.NotEqual(t, content.ContentID, v.ContentID)
+	}
+
+	// A sponsored article must name its sponsor
+	err = testQueries.UpdateContentSponsor(context.Background(), UpdateContentSponsorParams{
+		ContentID:   content.ContentID,
+		IsSponsored: true,
+	})
+	require.Error(t, err)
+}
+
+func TestRecordSponsoredView(t *testing.T) {
+	sponsored := createRandomContent(t)
+	plain := createRandomContent(t)
+
+	err := testQueries.UpdateContentSponsor(context.Background(), UpdateContentSponsorParams{
+		ContentID:   sponsored.ContentID,
+		IsSponsored: true,
+		SponsorName: pgtype.Text{String: "Sponzor d.o.o.", Valid: true},
+	})
+	require.NoError(t, err)
+
+	today := pgtype.Date{Time: time.Now(), Valid: true}
+
+	for _, content := range []Content{sponsored, sponsored, plain} {
+		err := testQueries.RecordSponsoredView(context.Background(), RecordSponsoredViewParams{
+			StatDate:  today,
+			ContentID: content.ContentID,
+		})
+		require.NoError(t, err)
+	}
+
+	stats, err := testQueries.ListSponsoredDailyStats(context.Background(), ListSponsoredDailyStatsParams{
+		ContentID: sponsored.ContentID,
+		StartDate: today,
+		EndDate:   today,
+	})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, int32(2), stats[0].Views)
+
+	stats, err = testQueries.ListSponsoredDailyStats(context.Background(), ListSponsoredDailyStatsParams{
+		ContentID: plain.ContentID,
+		StartDate: today,
+		EndDate:   today,
+	})
+	require.NoError(t, err)
+	require.Empty(t, stats)
+}
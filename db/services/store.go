@@ -1,6 +1,11 @@
 package db
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -18,7 +23,7 @@ func NewStore(db *pgxpool.Pool) *Store {
 }
 
 // execTx executes a function within a database transaction
-/*func (store *Store) execTx(ctx context.Context, fn func(*Queries) error) error {
+func (store *Store) execTx(ctx context.Context, fn func(*Queries) error) error {
 	txOptions := pgx.TxOptions{}
 	tx, err := store.db.BeginTx(ctx, txOptions)
 	if err != nil {
@@ -35,4 +40,35 @@ func NewStore(db *pgxpool.Pool) *Store {
 	}
 
 	return tx.Commit(ctx)
-}*/
+}
+
+// LiftUserSanctionTxResult is the lifted sanction and the comments it
+// published.
+type LiftUserSanctionTxResult struct {
+	Sanction   UserSanction
+	Unshadowed []UnshadowCommentsRow
+}
+
+// LiftUserSanctionTx lifts a sanction. Lifting the user's last shadow-ban
+// publishes the comments they posted under it.
+func (store *Store) LiftUserSanctionTx(ctx context.Context, sanctionID pgtype.UUID) (LiftUserSanctionTxResult, error) {
+	var result LiftUserSanctionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Sanction, err = q.LiftUserSanction(ctx, sanctionID)
+		if err != nil {
+			return err
+		}
+
+		if result.Sanction.Kind != "shadowban" {
+			return nil
+		}
+
+		result.Unshadowed, err = q.UnshadowComments(ctx, result.Sanction.UserID)
+		return err
+	})
+
+	return result, err
+}
@@ -794,6 +794,22 @@ func (q *Queries) SetEmailVerified(ctx context.Context, userID pgtype.UUID) erro
 	return err
 }
 
+const setUserRole = `-- name: SetUserRole :exec
+UPDATE "user" 
+SET role = $2 
+WHERE user_id = $1
+`
+
+type SetUserRoleParams struct {
+	UserID pgtype.UUID
+	Role   string
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) error {
+	_, err := q.db.Exec(ctx, setUserRole, arg.UserID, arg.Role)
+	return err
+}
+
 const unbanUser = `-- name: UnbanUser :exec
 UPDATE "user" 
 SET banned = false 
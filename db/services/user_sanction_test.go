@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSanctions(t *testing.T) {
+	user := createRandomUser(t)
+
+	suspension, err := testQueries.CreateUserSanction(context.Background(), CreateUserSanctionParams{
+		UserID:    user.UserID,
+		Kind:      "suspension",
+		Reason:    "Vređanje drugih korisnika",
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "suspension", suspension.Kind)
+	require.Equal(t, "Vređanje drugih korisnika", suspension.Reason)
+	require.False(t, suspension.LiftedAt.Valid)
+
+	// Expired sanctions are no longer in force
+	_, err = testQueries.CreateUserSanction(context.Background(), CreateUserSanctionParams{
+		UserID:    user.UserID,
+		Kind:      "mute",
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	shadowBan, err := testQueries.CreateUserSanction(context.Background(), CreateUserSanctionParams{
+		UserID: user.UserID,
+		Kind:   "shadowban",
+	})
+	require.NoError(t, err)
+	require.False(t, shadowBan.ExpiresAt.Valid)
+
+	active, err := testQueries.ListActiveUserSanctions(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	require.Equal(t, shadowBan.SanctionID, active[0].SanctionID)
+	require.Equal(t, suspension.SanctionID, active[1].SanctionID)
+
+	lifted, err := testQueries.LiftUserSanction(context.Background(), suspension.SanctionID)
+	require.NoError(t, err)
+	require.True(t, lifted.LiftedAt.Valid)
+
+	// A lifted sanction can't be lifted again
+	_, err = testQueries.LiftUserSanction(context.Background(), suspension.SanctionID)
+	require.Error(t, err)
+
+	err = testQueries.LiftUserSanctionsByKind(context.Background(), LiftUserSanctionsByKindParams{
+		UserID: user.UserID,
+		Kind:   "shadowban",
+	})
+	require.NoError(t, err)
+
+	active, err = testQueries.ListActiveUserSanctions(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Empty(t, active)
+
+	history, err := testQueries.ListUserSanctions(context.Background(), ListUserSanctionsParams{
+		UserID: user.UserID,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+}
+
+func TestLiftUserSanctionTx(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+	content := createRandomContent(t)
+
+	shadowBan, err := testQueries.CreateUserSanction(context.Background(), CreateUserSanctionParams{
+		UserID: user.UserID,
+		Kind:   "shadowban",
+	})
+	require.NoError(t, err)
+
+	comment, err := testQueries.CreateComment(context.Background(), CreateCommentParams{
+		ContentID:   content.ContentID,
+		UserID:      user.UserID,
+		CommentText: "Komentar pod zabranom",
+		IsShadowed:  true,
+	})
+	require.NoError(t, err)
+
+	before, err := testQueries.GetContentDetails(context.Background(), content.ContentID)
+	require.NoError(t, err)
+
+	result, err := store.LiftUserSanctionTx(context.Background(), shadowBan.SanctionID)
+	require.NoError(t, err)
+	require.True(t, result.Sanction.LiftedAt.Valid)
+	require.Len(t, result.Unshadowed, 1)
+	require.Equal(t, comment.CommentID, result.Unshadowed[0].CommentID)
+
+	// The comment is counted now that everyone sees it
+	after, err := testQueries.GetContentDetails(context.Background(), content.ContentID)
+	require.NoError(t, err)
+	require.Equal(t, before.CommentCount+1, after.CommentCount)
+
+	// A lifted sanction can't be lifted again, nothing changes
+	_, err = store.LiftUserSanctionTx(context.Background(), shadowBan.SanctionID)
+	require.Error(t, err)
+}
+
+func TestUnshadowCommentsUnderActiveBan(t *testing.T) {
+	user := createRandomUser(t)
+	content := createRandomContent(t)
+
+	_, err := testQueries.CreateUserSanction(context.Background(), CreateUserSanctionParams{
+		UserID: user.UserID,
+		Kind:   "shadowban",
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.CreateComment(context.Background(), CreateCommentParams{
+		ContentID:   content.ContentID,
+		UserID:      user.UserID,
+		CommentText: "Komentar pod zabranom",
+		IsShadowed:  true,
+	})
+	require.NoError(t, err)
+
+	// The ban is still in force
+	unshadowed, err := testQueries.UnshadowComments(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Empty(t, unshadowed)
+}
@@ -363,3 +363,14 @@ func TestSetEmailVerified(t *testing.T) {
 	err := testQueries.SetEmailVerified(context.Background(), user.UserID)
 	require.NoError(t, err)
 }
+
+func TestSetUserRole(t *testing.T) {
+	user := createRandomUser(t)
+
+	err := testQueries.SetUserRole(context.Background(), SetUserRoleParams{UserID: user.UserID, Role: "advertiser"})
+	require.NoError(t, err)
+
+	updated, err := testQueries.GetUserByID(context.Background(), user.UserID)
+	require.NoError(t, err)
+	require.Equal(t, "advertiser", updated.Role)
+}
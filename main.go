@@ -45,9 +45,11 @@ func main() {
 	redis.InitRedis()
 	pong, err := redis.Client.Ping(redis.Ctx).Result()
 	if err != nil {
-		log.Fatalf("Cannot connect to Redis! %v", err)
+		// The cache falls back to memory and picks Redis up once it's reachable
+		log.Printf("Cannot connect to Redis, starting without it: %v", err)
+	} else {
+		log.Printf("Connected to Redis: %s", pong)
 	}
-	log.Printf("Connected to Redis: %s", pong)
 
 	// Initialize the store and pass it into the server
 	store := db.NewStore(conn)
@@ -41,6 +41,7 @@ document.addEventListener('DOMContentLoaded', function() {
         document.getElementById('pregled'),
         document.getElementById('kategorije'),
         document.getElementById('artikli'),
+        document.getElementById('duplikati'),
         document.getElementById('korisnici'),
         document.getElementById('reklame')
     ];
@@ -77,3 +78,20 @@ document.body.addEventListener('htmx:beforeSwap', function() {
 document.body.addEventListener('htmx:afterSwap', function() {
     window.scrollTo({ top: lastScrollY, behavior: 'instant' });
 });
+
+// Focal point picker on media tiles, the surrounding htmx form saves it on change
+function setFocalPoint(event, picker) {
+    const rect = picker.getBoundingClientRect();
+    const x = Math.min(Math.max((event.clientX - rect.left) / rect.width, 0), 1);
+    const y = Math.min(Math.max((event.clientY - rect.top) / rect.height, 0), 1);
+
+    const marker = picker.querySelector('[data-focal-marker]');
+    marker.style.left = (x * 100).toFixed(1) + '%';
+    marker.style.top = (y * 100).toFixed(1) + '%';
+
+    const form = picker.closest('form');
+    const focalX = form.querySelector('[name="focal_x"]');
+    form.querySelector('[name="focal_y"]').value = y.toFixed(3);
+    focalX.value = x.toFixed(3);
+    focalX.dispatchEvent(new Event('change', { bubbles: true }));
+}
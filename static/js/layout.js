@@ -63,8 +63,49 @@ document.body.addEventListener('htmx:afterSwap', function() {
     window.scrollTo({ top: lastScrollY, behavior: 'instant' });
 });
 
-function sendAdClick() {
-    htmx.ajax('POST', '/api/increment-ads-clicks', {
-        swap: 'none'
+// Counts an ad once at least half of it has been on screen.
+const adObserver = 'IntersectionObserver' in window ? new IntersectionObserver(function(entries) {
+    entries.forEach(function(entry) {
+        if (!entry.isIntersecting) return;
+
+        adObserver.unobserve(entry.target);
+        navigator.sendBeacon(`/api/ads/${entry.target.dataset.adId}/impression`);
+    });
+}, { threshold: 0.5 }) : null;
+
+function observeAds(root) {
+    if (!adObserver) return;
+
+    root.querySelectorAll('[data-ad-id]:not([data-ad-observed])').forEach(function(ad) {
+        ad.dataset.adObserved = 'true';
+        adObserver.observe(ad);
     });
 }
+
+// Asks the server which ads to show in the page's ad slots, the response
+// fills them out of band.
+function loadAdSlots() {
+    const params = new URLSearchParams();
+    document.querySelectorAll('[data-ad-slot]').forEach(function(slot) {
+        params.append('placement', slot.dataset.adSlot);
+    });
+    if (!params.has('placement')) return;
+    params.append('path', window.location.pathname);
+
+    // What the page is, the server looks up what it is about for targeted ads
+    const context = document.querySelector('[data-ad-context]');
+    if (context) {
+        if (context.dataset.pageType) params.append('page_type', context.dataset.pageType);
+        if (context.dataset.content) params.append('content', context.dataset.content);
+    }
+
+    htmx.ajax('GET', `/api/ads/slots?${params}`, { swap: 'none' });
+}
+
+document.addEventListener('DOMContentLoaded', function() {
+    loadAdSlots();
+});
+
+document.body.addEventListener('htmx:afterSettle', function(evt) {
+    observeAds(document);
+});
@@ -0,0 +1,64 @@
+// Live comment updates. Every event carries htmx out-of-band fragments: each
+// top-level element replaces the element with the same id, or is inserted
+// into it or deletes it, as its hx-swap-oob attribute says.
+document.addEventListener('DOMContentLoaded', function() {
+    const live = document.getElementById('live-comments');
+    if (!live || !window.EventSource) {
+        return;
+    }
+
+    // New comments only belong on top of the newest-first order
+    function sortedByScore() {
+        const select = document.getElementById('comment-sort-select');
+        if (!select) {
+            return false;
+        }
+        const url = select.options[select.selectedIndex].getAttribute('data-url') || '';
+        return url.includes('/score');
+    }
+
+    function swap(html) {
+        const template = document.createElement('template');
+        template.innerHTML = html;
+
+        for (const fragment of Array.from(template.content.children)) {
+            const target = document.getElementById(fragment.id);
+            if (!target) {
+                continue;
+            }
+
+            const style = fragment.getAttribute('hx-swap-oob') || 'true';
+            fragment.removeAttribute('hx-swap-oob');
+
+            if (style === 'delete') {
+                target.remove();
+            } else if (style === 'afterbegin' || style === 'beforeend') {
+                if (fragment.id === 'comments-container' && sortedByScore()) {
+                    continue;
+                }
+                // The author's page already shows what they posted
+                const nodes = Array.from(fragment.children).filter(function(node) {
+                    return !node.id || !document.getElementById(node.id);
+                });
+                if (style === 'afterbegin') {
+                    target.prepend(...nodes);
+                } else {
+                    target.append(...nodes);
+                }
+                nodes.forEach(function(node) {
+                    htmx.process(node);
+                });
+            } else {
+                target.replaceWith(fragment);
+                htmx.process(fragment);
+            }
+        }
+    }
+
+    const source = new EventSource(live.dataset.url);
+    ['comment', 'reply', 'edit', 'score', 'delete'].forEach(function(name) {
+        source.addEventListener(name, function(event) {
+            swap(event.data);
+        });
+    });
+});
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Formats an ad placement can allow
+const (
+	AdFormatImage = "image"
+	AdFormatVideo = "video"
+)
+
+var adVideoExts = map[string]bool{".mp4": true, ".mov": true, ".avi": true, ".webm": true}
+
+// AdFormat returns the format of an ad creative from its file name.
+func AdFormat(path string) string {
+	if adVideoExts[strings.ToLower(filepath.Ext(path))] {
+		return AdFormatVideo
+	}
+	return AdFormatImage
+}
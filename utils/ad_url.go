@@ -0,0 +1,28 @@
+package utils
+
+import "os"
+
+func adSigningKey() []byte {
+	return []byte(os.Getenv("AD_SIGNING_KEY"))
+}
+
+// SignAdID returns the HMAC signature of an ad's click link.
+func SignAdID(adID string) string {
+	return sign(adSigningKey(), "ad", adID)
+}
+
+// VerifyAdSignature reports whether sig was produced by SignAdID for the same ad.
+func VerifyAdSignature(sig, adID string) bool {
+	return verify(adSigningKey(), sig, "ad", adID)
+}
+
+// AdURL returns the signed /go/ad link that counts a click and redirects to
+// the advertiser. Without a signing key the target URL is returned unchanged,
+// so ads keep working but clicks aren't counted.
+func AdURL(adID, target string) string {
+	if len(adSigningKey()) == 0 {
+		return target
+	}
+
+	return "/go/ad/" + adID + "?sig=" + SignAdID(adID)
+}
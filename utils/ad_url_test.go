@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Click links already published in cached pages must keep verifying
+func TestSignAdID(t *testing.T) {
+	t.Setenv("AD_SIGNING_KEY", "12345678901234567890123456789012")
+
+	mac := hmac.New(sha256.New, []byte("12345678901234567890123456789012"))
+	mac.Write([]byte("ad/abc"))
+	expected := base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:16])
+
+	require.Equal(t, expected, SignAdID("abc"))
+	require.True(t, VerifyAdSignature(expected, "abc"))
+	require.False(t, VerifyAdSignature(expected, "abd"))
+	require.Equal(t, "/go/ad/abc?sig="+expected, AdURL("abc", "https://primer.rs"))
+
+	// Without a key ads link straight to the advertiser and nothing verifies
+	t.Setenv("AD_SIGNING_KEY", "")
+	require.Equal(t, "https://primer.rs", AdURL("abc", "https://primer.rs"))
+	require.False(t, VerifyAdSignature(expected, "abc"))
+}
@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// botRegexp matches the user agents of crawlers, link previews, monitoring
+// and HTTP libraries
+var botRegexp = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|archiver|facebookexternalhit|embedly|preview|headless|phantomjs|lighthouse|pingdom|uptime|monitor|curl|wget|python|go-http-client|java/|okhttp|axios|node-fetch|libwww|scrapy`)
+
+// IsBot reports whether a request with this user agent comes from a bot.
+// Browsers always send a user agent, so an empty one counts as a bot.
+func IsBot(userAgent string) bool {
+	userAgent = strings.TrimSpace(userAgent)
+	if userAgent == "" {
+		return true
+	}
+
+	return botRegexp.MatchString(userAgent)
+}
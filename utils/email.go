@@ -2,7 +2,9 @@
 package utils
 
 import (
+	"html"
 	"os"
+	"strings"
 
 	"gopkg.in/gomail.v2"
 )
@@ -107,3 +109,90 @@ func SendEmailVerificationEmail(recipient, verificationLink string) error {
 
 	return nil
 }
+
+// SendCommentNotificationEmail tells the recipient that someone replied to
+// or mentioned them in a comment on the given article
+func SendCommentNotificationEmail(recipient, actor, contentTitle string, mention bool, commentLink string) error {
+	config := NewEmailConfig()
+	host := config.Host
+	port := config.Port
+	username := config.Username
+	password := config.Password
+
+	heading := "Novi odgovor na vaš komentar"
+	action := "je odgovorio/la na vaš komentar"
+	if mention {
+		heading = "Pomenuti ste u komentaru"
+		action = "vas je pomenuo/la u komentaru"
+	}
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", username)
+	m.SetHeader("To", recipient)
+	m.SetHeader("Subject", "Mačva Press - "+heading)
+
+	htmlBody := `
+	<div style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto;">
+		<h2>` + heading + `</h2>
+		<p><strong>` + html.EscapeString(actor) + `</strong> ` + action + ` na članku „` + html.EscapeString(contentTitle) + `”.</p>
+		<p style="margin: 30px 0;">
+			<a href="` + commentLink + `" style="background-color: #3B82F6; color: white; padding: 12px 20px; text-decoration: none; border-radius: 5px; font-weight: bold;">Pogledaj Komentar</a>
+		</p>
+		<p>Email obaveštenja možete isključiti u podešavanjima naloga.</p>
+		<hr style="margin: 30px 0; border: none; border-top: 1px solid #eaeaea;" />
+		<p style="font-size: 12px; color: #666;">Mačva Press Tim</p>
+	</div>
+	`
+	m.SetBody("text/html", htmlBody)
+
+	d := gomail.NewDialer(host, port, username, password)
+
+	if err := d.DialAndSend(m); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SendAdExpiryEmail warns an account manager of the campaigns that end soon,
+// so they can be extended or wound down with the advertiser
+func SendAdExpiryEmail(recipient string, campaigns []string, adsLink string) error {
+	config := NewEmailConfig()
+	host := config.Host
+	port := config.Port
+	username := config.Username
+	password := config.Password
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", username)
+	m.SetHeader("To", recipient)
+	m.SetHeader("Subject", "Mačva Press - Kampanje uskoro ističu")
+
+	var list strings.Builder
+	for _, campaign := range campaigns {
+		list.WriteString("<li>" + html.EscapeString(campaign) + "</li>")
+	}
+
+	htmlBody := `
+	<div style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto;">
+		<h2>Kampanje uskoro ističu</h2>
+		<p>Sledeće kampanje se završavaju u narednih nekoliko dana:</p>
+		<ul>` + list.String() + `</ul>
+		<p style="margin: 30px 0;">
+			<a href="` + adsLink + `" style="background-color: #3B82F6; color: white; padding: 12px 20px; text-decoration: none; border-radius: 5px; font-weight: bold;">Pogledaj Oglase</a>
+		</p>
+		<p>Kampanju možete produžiti na stranici oglasa.</p>
+		<hr style="margin: 30px 0; border: none; border-top: 1px solid #eaeaea;" />
+		<p style="font-size: 12px; color: #666;">Mačva Press Tim</p>
+	</div>
+	`
+	m.SetBody("text/html", htmlBody)
+
+	d := gomail.NewDialer(host, port, username, password)
+
+	if err := d.DialAndSend(m); err != nil {
+		return err
+	}
+
+	return nil
+}
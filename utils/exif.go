@@ -0,0 +1,357 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// ImageMetadata holds the editorial fields we care about from an uploaded photo.
+type ImageMetadata struct {
+	Caption string
+	Credit  string
+}
+
+// Placeholder descriptions written by cameras that should never end up as a caption
+var cameraPlaceholderDescriptions = map[string]bool{
+	"OLYMPUS DIGITAL CAMERA": true,
+	"SONY DSC":               true,
+	"DIGITAL CAMERA":         true,
+	"DCIM":                   true,
+}
+
+// ReadImageMetadata extracts caption and photographer credit from the EXIF and
+// IPTC blocks of a JPEG file. Other formats return empty metadata.
+func ReadImageMetadata(path string) (ImageMetadata, error) {
+	var meta ImageMetadata
+
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext != ".jpg" && ext != ".jpeg" {
+		return meta, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return meta, fmt.Errorf("error reading image file: %v", err)
+	}
+
+	var exifTags, iptcTags map[int]string
+	err = walkJPEGSegments(data, func(marker byte, payload []byte) bool {
+		switch {
+		case marker == 0xE1 && bytes.HasPrefix(payload, []byte("Exif\x00\x00")):
+			exifTags = parseEXIFStrings(payload[6:])
+		case marker == 0xED && bytes.HasPrefix(payload, []byte("Photoshop 3.0\x00")):
+			iptcTags = parseIPTC(payload[14:])
+		}
+		return true
+	})
+	if err != nil {
+		return meta, err
+	}
+
+	// IPTC is what agencies and photo desks fill in, so it wins over EXIF
+	meta.Caption = firstNonEmpty(iptcTags[120], exifTags[0x010E])
+	meta.Credit = firstNonEmpty(iptcTags[80], exifTags[0x013B], iptcTags[116], exifTags[0x8298])
+
+	if cameraPlaceholderDescriptions[strings.ToUpper(meta.Caption)] {
+		meta.Caption = ""
+	}
+
+	return meta, nil
+}
+
+// StripImageMetadata rewrites a JPEG, PNG or WebP file in place without its
+// EXIF, XMP, IPTC and text blocks, removing GPS coordinates, device serials
+// and author details. Files in other formats are left untouched.
+func StripImageMetadata(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading image file: %v", err)
+	}
+
+	var stripped []byte
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jpg", ".jpeg":
+		stripped, err = stripJPEGMetadata(data)
+	case ".png":
+		stripped, err = stripPNGMetadata(data)
+	case ".webp":
+		stripped, err = stripWebPMetadata(data)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, stripped, 0644)
+}
+
+// walkJPEGSegments calls fn for every marker segment before the image data.
+// Returning false from fn stops the walk.
+func walkJPEGSegments(data []byte, fn func(marker byte, payload []byte) bool) error {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return fmt.Errorf("not a JPEG file")
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return fmt.Errorf("invalid JPEG marker at offset %d", pos)
+		}
+		marker := data[pos+1]
+		// Start of scan - no more metadata after this point
+		if marker == 0xDA || marker == 0xD9 {
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if length < 2 || pos+2+length > len(data) {
+			return fmt.Errorf("invalid JPEG segment length at offset %d", pos)
+		}
+		if !fn(marker, data[pos+4:pos+2+length]) {
+			return nil
+		}
+		pos += 2 + length
+	}
+
+	return nil
+}
+
+func stripJPEGMetadata(data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	out = append(out, 0xFF, 0xD8)
+
+	pos := 2
+	err := walkJPEGSegments(data, func(marker byte, payload []byte) bool {
+		segment := data[pos : pos+4+len(payload)]
+		pos += len(segment)
+
+		// APP1 (EXIF/XMP), APP13 (IPTC) and COM carry metadata, keep everything else
+		// including APP2 so embedded ICC color profiles survive.
+		if marker == 0xE1 || marker == 0xED || marker == 0xFE {
+			return true
+		}
+		out = append(out, segment...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(out, data[pos:]...), nil
+}
+
+func stripPNGMetadata(data []byte) ([]byte, error) {
+	signature := []byte("\x89PNG\r\n\x1a\n")
+	if !bytes.HasPrefix(data, signature) {
+		return nil, fmt.Errorf("not a PNG file")
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, signature...)
+
+	pos := len(signature)
+	for pos+12 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos : pos+4]))
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, fmt.Errorf("invalid PNG chunk length at offset %d", pos)
+		}
+		switch string(data[pos+4 : pos+8]) {
+		case "eXIf", "tEXt", "zTXt", "iTXt", "tIME":
+		default:
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+
+	return out, nil
+}
+
+func stripWebPMetadata(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, fmt.Errorf("not a WebP file")
+	}
+
+	out := make([]byte, 12, len(data))
+	copy(out, data[:12])
+
+	pos := 12
+	for pos+8 <= len(data) {
+		size := int(binary.LittleEndian.Uint32(data[pos+4 : pos+8]))
+		end := pos + 8 + size + size%2 // chunks are padded to even sizes
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk := data[pos:end]
+
+		switch string(chunk[0:4]) {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			vp8x := append([]byte(nil), chunk...)
+			if len(vp8x) > 8 {
+				// Clear the EXIF (0x08) and XMP (0x04) presence flags
+				vp8x[8] &^= 0x08 | 0x04
+			}
+			out = append(out, vp8x...)
+		default:
+			out = append(out, chunk...)
+		}
+		pos = end
+	}
+
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out, nil
+}
+
+// parseEXIFStrings returns the ASCII tags of IFD0 keyed by tag ID.
+func parseEXIFStrings(tiff []byte) map[int]string {
+	tags := make(map[int]string)
+	if len(tiff) < 8 {
+		return tags
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return tags
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return tags
+	}
+
+	count := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		tag := int(order.Uint16(tiff[entry : entry+2]))
+		typ := order.Uint16(tiff[entry+2 : entry+4])
+		n := int(order.Uint32(tiff[entry+4 : entry+8]))
+
+		// Only ASCII values are interesting here
+		if typ != 2 || n == 0 {
+			continue
+		}
+
+		var value []byte
+		if n <= 4 {
+			value = tiff[entry+8 : entry+8+n]
+		} else {
+			offset := int(order.Uint32(tiff[entry+8 : entry+12]))
+			if offset < 0 || offset+n > len(tiff) {
+				continue
+			}
+			value = tiff[offset : offset+n]
+		}
+
+		tags[tag] = cleanMetadataString(value)
+	}
+
+	return tags
+}
+
+// parseIPTC returns the IPTC application record (2:xx) datasets found in the
+// Photoshop image resources of an APP13 segment, keyed by dataset number.
+func parseIPTC(resources []byte) map[int]string {
+	tags := make(map[int]string)
+
+	pos := 0
+	for pos+12 <= len(resources) {
+		if string(resources[pos:pos+4]) != "8BIM" {
+			break
+		}
+		id := binary.BigEndian.Uint16(resources[pos+4 : pos+6])
+
+		// Pascal string name, padded to an even length including the length byte
+		nameLen := int(resources[pos+6])
+		pos += 6 + 1 + nameLen
+		if (1+nameLen)%2 != 0 {
+			pos++
+		}
+		if pos+4 > len(resources) {
+			break
+		}
+
+		size := int(binary.BigEndian.Uint32(resources[pos : pos+4]))
+		pos += 4
+		if size < 0 || pos+size > len(resources) {
+			break
+		}
+
+		// 0x0404 is the IPTC-NAA record
+		if id == 0x0404 {
+			parseIPTCRecords(resources[pos:pos+size], tags)
+		}
+
+		pos += size + size%2
+	}
+
+	return tags
+}
+
+func parseIPTCRecords(data []byte, tags map[int]string) {
+	pos := 0
+	for pos+5 <= len(data) {
+		if data[pos] != 0x1C {
+			return
+		}
+		record := data[pos+1]
+		dataset := int(data[pos+2])
+		size := int(binary.BigEndian.Uint16(data[pos+3 : pos+5]))
+		pos += 5
+
+		// Extended datasets are never used for the text fields we read
+		if size&0x8000 != 0 {
+			return
+		}
+		if pos+size > len(data) {
+			return
+		}
+
+		if record == 2 {
+			value := cleanMetadataString(data[pos : pos+size])
+			// Repeated fields (e.g. multiple by-lines) are joined
+			if existing := tags[dataset]; existing != "" && value != "" {
+				value = existing + ", " + value
+			}
+			tags[dataset] = value
+		}
+		pos += size
+	}
+}
+
+// cleanMetadataString trims padding and converts Latin-1 text to UTF-8.
+func cleanMetadataString(value []byte) string {
+	value = bytes.TrimRight(value, "\x00")
+
+	if !utf8.Valid(value) {
+		runes := make([]rune, len(value))
+		for i, b := range value {
+			runes[i] = rune(b)
+		}
+		return strings.TrimSpace(string(runes))
+	}
+
+	return strings.TrimSpace(string(value))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
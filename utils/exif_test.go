@@ -0,0 +1,261 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chai2010/webp"
+	"github.com/disintegration/imaging"
+	"github.com/stretchr/testify/require"
+)
+
+// testImage is 4x2 so a rotation shows in its bounds
+func testImage() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 2; y++ {
+			img.Set(x, y, color.RGBA{uint8(x * 60), uint8(y * 120), 80, 255})
+		}
+	}
+	return img
+}
+
+// testTIFF is a big-endian IFD0 with a description, an orientation and an
+// artist, what a phone writes along with the GPS block.
+func testTIFF(orientation uint16, description, artist string) []byte {
+	desc := append([]byte(description), 0)
+	art := append([]byte(artist), 0)
+
+	const entries = 3
+	dataOffset := 8 + 2 + entries*12 + 4
+
+	var b bytes.Buffer
+	b.WriteString("MM")
+	binary.Write(&b, binary.BigEndian, uint16(42))
+	binary.Write(&b, binary.BigEndian, uint32(8))
+	binary.Write(&b, binary.BigEndian, uint16(entries))
+
+	entry := func(tag, typ uint16, count uint32, value uint32) {
+		binary.Write(&b, binary.BigEndian, tag)
+		binary.Write(&b, binary.BigEndian, typ)
+		binary.Write(&b, binary.BigEndian, count)
+		binary.Write(&b, binary.BigEndian, value)
+	}
+	entry(0x010E, 2, uint32(len(desc)), uint32(dataOffset))
+	// A SHORT is left-justified in the value field
+	entry(0x0112, 3, 1, uint32(orientation)<<16)
+	entry(0x013B, 2, uint32(len(art)), uint32(dataOffset+len(desc)))
+	binary.Write(&b, binary.BigEndian, uint32(0))
+
+	b.Write(desc)
+	b.Write(art)
+	return b.Bytes()
+}
+
+func testJPEG(t *testing.T, orientation uint16, description, artist string) []byte {
+	var b bytes.Buffer
+	require.NoError(t, jpeg.Encode(&b, testImage(), nil))
+	data := b.Bytes()
+
+	payload := append([]byte("Exif\x00\x00"), testTIFF(orientation, description, artist)...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	// APP1 goes right after SOI
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+func pngChunk(typ string, data []byte) []byte {
+	chunk := make([]byte, 8, 12+len(data))
+	binary.BigEndian.PutUint32(chunk[0:4], uint32(len(data)))
+	copy(chunk[4:8], typ)
+	chunk = append(chunk, data...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+func testPNG(t *testing.T) []byte {
+	var b bytes.Buffer
+	require.NoError(t, png.Encode(&b, testImage()))
+	data := b.Bytes()
+
+	// Metadata chunks go before IEND, the last 12 bytes
+	iend := len(data) - 12
+	out := append([]byte{}, data[:iend]...)
+	out = append(out, pngChunk("eXIf", testTIFF(1, "Opis", "Autor"))...)
+	out = append(out, pngChunk("tEXt", []byte("Author\x00Autor"))...)
+	return append(out, data[iend:]...)
+}
+
+func webpChunk(typ string, data []byte) []byte {
+	chunk := make([]byte, 8, 9+len(data))
+	copy(chunk[0:4], typ)
+	binary.LittleEndian.PutUint32(chunk[4:8], uint32(len(data)))
+	chunk = append(chunk, data...)
+	if len(data)%2 != 0 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+// testWebP is an extended WebP, the only kind that can carry EXIF
+func testWebP(t *testing.T) []byte {
+	var b bytes.Buffer
+	require.NoError(t, webp.Encode(&b, testImage(), &webp.Options{Quality: 90}))
+	simple := b.Bytes()
+
+	vp8x := make([]byte, 10)
+	vp8x[0] = 0x08 // EXIF present
+	vp8x[4] = 4 - 1
+	vp8x[7] = 2 - 1
+
+	out := []byte("RIFF\x00\x00\x00\x00WEBP")
+	out = append(out, webpChunk("VP8X", vp8x)...)
+	out = append(out, simple[12:]...)
+	out = append(out, webpChunk("EXIF", testTIFF(1, "Opis", "Autor"))...)
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out
+}
+
+func writeTestFile(t *testing.T, name string, data []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, data, 0644))
+	return path
+}
+
+func TestStripImageMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		file        string
+		data        func(t *testing.T) []byte
+		hasMetadata func(data []byte) bool
+	}{
+		{
+			name: "JPEG",
+			file: "photo.jpg",
+			data: func(t *testing.T) []byte { return testJPEG(t, 1, "Opis", "Autor") },
+			hasMetadata: func(data []byte) bool {
+				found := false
+				walkJPEGSegments(data, func(marker byte, payload []byte) bool {
+					found = found || marker == 0xE1
+					return true
+				})
+				return found
+			},
+		},
+		{
+			name: "PNG",
+			file: "photo.png",
+			data: testPNG,
+			hasMetadata: func(data []byte) bool {
+				return bytes.Contains(data, []byte("eXIf")) || bytes.Contains(data, []byte("tEXt"))
+			},
+		},
+		{
+			name: "WebP",
+			file: "photo.webp",
+			data: testWebP,
+			hasMetadata: func(data []byte) bool {
+				return bytes.Contains(data, []byte("EXIF")) || data[20]&0x08 != 0
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := tc.data(t)
+			require.True(t, tc.hasMetadata(data))
+
+			path := writeTestFile(t, tc.file, data)
+			require.NoError(t, StripImageMetadata(path))
+
+			stripped, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.False(t, tc.hasMetadata(stripped))
+			require.Less(t, len(stripped), len(data))
+
+			img, err := imaging.Open(path)
+			require.NoError(t, err)
+			require.Equal(t, image.Rect(0, 0, 4, 2), img.Bounds())
+		})
+	}
+}
+
+func TestStripImageMetadataInvalid(t *testing.T) {
+	testCases := []struct {
+		file string
+		data []byte
+	}{
+		{"photo.jpg", []byte("not a jpeg")},
+		{"photo.png", []byte("not a png")},
+		{"photo.webp", []byte("RIFF\x00\x00\x00\x00WAVE")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.file, func(t *testing.T) {
+			require.Error(t, StripImageMetadata(writeTestFile(t, tc.file, tc.data)))
+		})
+	}
+
+	// Other formats are left as they are
+	path := writeTestFile(t, "photo.gif", []byte("GIF89a"))
+	require.NoError(t, StripImageMetadata(path))
+}
+
+func TestImageOrientation(t *testing.T) {
+	testCases := []struct {
+		orientation uint16
+		bounds      image.Rectangle
+	}{
+		{1, image.Rect(0, 0, 4, 2)},
+		{3, image.Rect(0, 0, 4, 2)},
+		{6, image.Rect(0, 0, 2, 4)},
+		{8, image.Rect(0, 0, 2, 4)},
+	}
+
+	for _, tc := range testCases {
+		path := writeTestFile(t, "photo.jpg", testJPEG(t, tc.orientation, "Opis", "Autor"))
+
+		img, err := imaging.Open(path, imaging.AutoOrientation(true))
+		require.NoError(t, err)
+		require.Equal(t, tc.bounds, img.Bounds(), "orientation %d", tc.orientation)
+	}
+}
+
+func TestReadImageMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		description string
+		artist      string
+		caption     string
+		credit      string
+	}{
+		{"caption and credit", "Sednica skupštine", "Petar Petrović", "Sednica skupštine", "Petar Petrović"},
+		{"camera placeholder", "OLYMPUS DIGITAL CAMERA", "Petar Petrović", "", "Petar Petrović"},
+		{"padded", "  Opis  ", "", "Opis", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeTestFile(t, "photo.jpg", testJPEG(t, 1, tc.description, tc.artist))
+
+			meta, err := ReadImageMetadata(path)
+			require.NoError(t, err)
+			require.Equal(t, tc.caption, meta.Caption)
+			require.Equal(t, tc.credit, meta.Credit)
+		})
+	}
+
+	// Only JPEGs carry the fields we read
+	meta, err := ReadImageMetadata(writeTestFile(t, "photo.png", testPNG(t)))
+	require.NoError(t, err)
+	require.Equal(t, ImageMetadata{}, meta)
+}
@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"image"
+	"math"
+
+	"github.com/disintegration/imaging"
+)
+
+// DefaultFocalPoint is used for both axes when nothing better is known
+const DefaultFocalPoint float32 = 0.5
+
+const (
+	focalSampleSize = 96 // the image is analysed at this size, detail beyond it doesn't matter
+	focalGridSize   = 8  // cells per side whose entropy is compared
+	focalLevels     = 32 // grayscale histogram bins per cell
+)
+
+// DetectFocalPoint opens the image at path and estimates where its subject is.
+// Images that can't be read fall back to the center.
+func DetectFocalPoint(path string) (float32, float32) {
+	img, err := imaging.Open(path, imaging.AutoOrientation(true))
+	if err != nil {
+		return DefaultFocalPoint, DefaultFocalPoint
+	}
+	return FocalPointOf(img)
+}
+
+// FocalPointOf estimates the subject of img as the centroid of its most
+// detailed regions. Busy areas (faces, text, objects) have a higher grayscale
+// entropy than sky, walls or blurred backgrounds. A flat image yields the center.
+func FocalPointOf(img image.Image) (float32, float32) {
+	small := imaging.Grayscale(imaging.Fit(img, focalSampleSize, focalSampleSize, imaging.Box))
+	bounds := small.Bounds()
+	if bounds.Dx() < focalGridSize || bounds.Dy() < focalGridSize {
+		return DefaultFocalPoint, DefaultFocalPoint
+	}
+
+	var entropies [focalGridSize][focalGridSize]float64
+	var total float64
+	for gy := 0; gy < focalGridSize; gy++ {
+		for gx := 0; gx < focalGridSize; gx++ {
+			cell := image.Rect(
+				bounds.Min.X+gx*bounds.Dx()/focalGridSize,
+				bounds.Min.Y+gy*bounds.Dy()/focalGridSize,
+				bounds.Min.X+(gx+1)*bounds.Dx()/focalGridSize,
+				bounds.Min.Y+(gy+1)*bounds.Dy()/focalGridSize,
+			)
+			entropies[gy][gx] = cellEntropy(small, cell)
+			total += entropies[gy][gx]
+		}
+	}
+
+	mean := total / (focalGridSize * focalGridSize)
+	if mean == 0 {
+		return DefaultFocalPoint, DefaultFocalPoint
+	}
+
+	// Only cells busier than average pull the point towards them, squared so
+	// the clearly interesting parts win over mild texture
+	var sumX, sumY, sumW float64
+	for gy := 0; gy < focalGridSize; gy++ {
+		for gx := 0; gx < focalGridSize; gx++ {
+			w := entropies[gy][gx] - mean
+			if w <= 0 {
+				continue
+			}
+			w *= w
+			sumX += w * (float64(gx) + 0.5) / focalGridSize
+			sumY += w * (float64(gy) + 0.5) / focalGridSize
+			sumW += w
+		}
+	}
+	if sumW == 0 {
+		return DefaultFocalPoint, DefaultFocalPoint
+	}
+
+	return ClampFocal(float32(sumX / sumW)), ClampFocal(float32(sumY / sumW))
+}
+
+func cellEntropy(img *image.NRGBA, cell image.Rectangle) float64 {
+	var hist [focalLevels]int
+	var n int
+	for y := cell.Min.Y; y < cell.Max.Y; y++ {
+		for x := cell.Min.X; x < cell.Max.X; x++ {
+			// Grayscale, so any channel will do
+			v := img.Pix[img.PixOffset(x, y)]
+			hist[int(v)*focalLevels/256]++
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+
+	var entropy float64
+	for _, count := range hist {
+		if count == 0 {
+			continue
+		}
+		p := float64(count) / float64(n)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// ClampFocal keeps a focal coordinate within the image (0 is left/top, 1 is right/bottom).
+func ClampFocal(v float32) float32 {
+	if v < 0 || math.IsNaN(float64(v)) {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+// CropToFocal scales img to cover width x height and crops the overflow so
+// that the focal point (fx, fy as fractions of the image size) stays as close
+// to the middle of the result as the image edges allow.
+func CropToFocal(img image.Image, width, height int, fx, fy float32) *image.NRGBA {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if srcW == 0 || srcH == 0 || width <= 0 || height <= 0 {
+		return imaging.Clone(img)
+	}
+
+	// Scale so the smaller side matches, the other side overflows the box
+	scale := math.Max(float64(width)/float64(srcW), float64(height)/float64(srcH))
+	scaledW := int(math.Round(float64(srcW) * scale))
+	scaledH := int(math.Round(float64(srcH) * scale))
+	if scaledW < width {
+		scaledW = width
+	}
+	if scaledH < height {
+		scaledH = height
+	}
+	scaled := imaging.Resize(img, scaledW, scaledH, imaging.Lanczos)
+
+	left := focalOffset(float64(ClampFocal(fx))*float64(scaledW), width, scaledW)
+	top := focalOffset(float64(ClampFocal(fy))*float64(scaledH), height, scaledH)
+
+	return imaging.Crop(scaled, image.Rect(left, top, left+width, top+height))
+}
+
+// focalOffset centers a window of size on point, without leaving [0, total)
+func focalOffset(point float64, size, total int) int {
+	offset := int(math.Round(point - float64(size)/2))
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total-size {
+		offset = total - size
+	}
+	return offset
+}
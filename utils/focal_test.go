@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFocalFill(t *testing.T) {
+	testCases := []struct {
+		x, y float32
+		fit  string
+	}{
+		{0.5, 0.5, ImageFill},
+		{0.3, 0.7, "fill-30-70"},
+		{0, 1, "fill-0-100"},
+		{-0.2, 1.5, "fill-0-100"},
+		{float32(math.NaN()), 0.25, "fill-0-25"},
+	}
+
+	for _, tc := range testCases {
+		fit := FocalFill(tc.x, tc.y)
+		require.Equal(t, tc.fit, fit)
+
+		mode, x, y, ok := ParseImageFit(fit)
+		require.True(t, ok)
+		require.Equal(t, ImageFill, mode)
+		require.InDelta(t, ClampFocal(tc.x), x, 0.01)
+		require.InDelta(t, ClampFocal(tc.y), y, 0.01)
+	}
+}
+
+func TestParseImageFit(t *testing.T) {
+	testCases := []struct {
+		fit  string
+		mode string
+		x, y float32
+		ok   bool
+	}{
+		{ImageFit, ImageFit, DefaultFocalPoint, DefaultFocalPoint, true},
+		{ImageFill, ImageFill, DefaultFocalPoint, DefaultFocalPoint, true},
+		{"fill-10-90", ImageFill, 0.1, 0.9, true},
+		{"fill-101-50", "", 0, 0, false},
+		{"fill--1-50", "", 0, 0, false},
+		{"fit-10-90", "", 0, 0, false},
+		{"fill-10", "", 0, 0, false},
+		{"fill-a-b", "", 0, 0, false},
+	}
+
+	for _, tc := range testCases {
+		mode, x, y, ok := ParseImageFit(tc.fit)
+		require.Equal(t, tc.ok, ok, tc.fit)
+		require.Equal(t, tc.mode, mode, tc.fit)
+		require.InDelta(t, tc.x, x, 0.001, tc.fit)
+		require.InDelta(t, tc.y, y, 0.001, tc.fit)
+	}
+}
+
+// noisyImage is flat gray but for random noise in rect, its subject
+func noisyImage(width, height int, rect image.Rectangle) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	rnd := rand.New(rand.NewSource(1))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := uint8(128)
+			if (image.Point{x, y}).In(rect) {
+				v = uint8(rnd.Intn(256))
+			}
+			img.SetGray(x, y, color.Gray{v})
+		}
+	}
+	return img
+}
+
+func TestFocalPointOf(t *testing.T) {
+	testCases := []struct {
+		name   string
+		img    image.Image
+		x, y   float32
+		within float64
+	}{
+		{"flat", noisyImage(200, 100, image.Rectangle{}), 0.5, 0.5, 0},
+		{"top left", noisyImage(200, 100, image.Rect(0, 0, 50, 25)), 0.125, 0.125, 0.1},
+		{"bottom right", noisyImage(200, 100, image.Rect(150, 75, 200, 100)), 0.875, 0.875, 0.1},
+		{"too small", noisyImage(4, 4, image.Rect(0, 0, 2, 2)), 0.5, 0.5, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, y := FocalPointOf(tc.img)
+			require.InDelta(t, tc.x, x, tc.within)
+			require.InDelta(t, tc.y, y, tc.within)
+		})
+	}
+}
+
+func TestCropToFocal(t *testing.T) {
+	// A white square on black, at a quarter of the width
+	img := image.NewGray(image.Rect(0, 0, 400, 100))
+	for y := 40; y < 60; y++ {
+		for x := 90; x < 110; x++ {
+			img.SetGray(x, y, color.Gray{255})
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		fx, fy   float32
+		inFrame  bool
+		centered bool
+	}{
+		{"center crop misses the subject", 0.5, 0.5, false, false},
+		{"focal point keeps it centered", 0.25, 0.5, true, true},
+		// The crop can't leave the image, the subject stays in frame off center
+		{"left edge", 0, 0.5, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crop := CropToFocal(img, 100, 100, tc.fx, tc.fy)
+			require.Equal(t, image.Rect(0, 0, 100, 100), crop.Bounds())
+
+			white := func(x, y int) bool { return crop.NRGBAAt(x, y).R > 200 }
+			found := false
+			for x := 0; x < 100 && !found; x++ {
+				found = white(x, 50)
+			}
+			require.Equal(t, tc.inFrame, found)
+			require.Equal(t, tc.centered, white(50, 50))
+		})
+	}
+}
+
+func TestFocalOffset(t *testing.T) {
+	testCases := []struct {
+		point       float64
+		size, total int
+		offset      int
+	}{
+		{200, 100, 400, 150},
+		{10, 100, 400, 0},
+		{390, 100, 400, 300},
+		{50, 100, 100, 0},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.offset, focalOffset(tc.point, tc.size, tc.total))
+	}
+}
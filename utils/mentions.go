@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// mentionRegexp matches @username at the start of a word, so email addresses don't count
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@-])@([\p{L}\p{N}_-]+)`)
+
+// ParseMentions returns the usernames mentioned in a comment, each once and
+// in lowercase, in the order they first appear.
+func ParseMentions(text string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+
+	for _, match := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		username := strings.ToLower(match[1])
+		if n := len([]rune(username)); n < 3 || n > 20 || seen[username] {
+			continue
+		}
+		seen[username] = true
+		usernames = append(usernames, username)
+	}
+
+	return usernames
+}
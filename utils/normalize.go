@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// serbianLatin spells Serbian Cyrillic and Latin letters with diacritics in
+// plain ASCII, the way readers type them without a Serbian keyboard
+var serbianLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'ђ': "dj", 'е': "e",
+	'ж': "z", 'з': "z", 'и': "i", 'ј': "j", 'к': "k", 'л': "l", 'љ': "lj",
+	'м': "m", 'н': "n", 'њ': "nj", 'о': "o", 'п': "p", 'р': "r", 'с': "s",
+	'т': "t", 'ћ': "c", 'у': "u", 'ф': "f", 'х': "h", 'ц': "c", 'ч': "c",
+	'џ': "dz", 'ш': "s",
+	'č': "c", 'ć': "c", 'đ': "dj", 'š': "s", 'ž': "z",
+}
+
+// NormalizeText lowercases text and spells it in plain Latin, so "Шпијун",
+// "Špijun" and "spijun" all become "spijun".
+func NormalizeText(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+
+	for _, r := range strings.ToLower(text) {
+		if latin, ok := serbianLatin[r]; ok {
+			b.WriteString(latin)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// PlainLatin spells text in plain Latin keeping its case, for fonts without
+// Serbian letters.
+func PlainLatin(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+
+	for _, r := range text {
+		lower := unicode.ToLower(r)
+		if latin, ok := serbianLatin[lower]; ok {
+			if r != lower {
+				latin = strings.ToUpper(latin[:1]) + latin[1:]
+			}
+			b.WriteString(latin)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// Tokenize returns the words of the normalized text, in order.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(NormalizeText(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
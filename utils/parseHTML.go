@@ -4,7 +4,10 @@ import (
 	"context"
 	"io"
 	"log"
+	"net/url"
+	"os"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
@@ -28,6 +31,49 @@ var youtubeEmbedRegexp = regexp.MustCompile(`^https:\/\/(www\.)?(youtube\.com|yo
 
 // ParseHTML sanitizes HTML and returns it as a templ.Component
 func ParseHTML(content string) templ.Component {
+	return HTMLComponent{content: sanitizeArticleHTML(content)}
+}
+
+// ParseSponsoredHTML is ParseHTML for paid articles, their links to other
+// sites are marked rel="sponsored" as search engines require.
+func ParseSponsoredHTML(content string) templ.Component {
+	sanitized := sanitizeArticleHTML(content)
+
+	var siteHost string
+	if base, err := url.Parse(os.Getenv("BASE_URL")); err == nil {
+		siteHost = base.Hostname()
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(sanitized))
+	if err != nil {
+		log.Printf("Error parsing HTML: %v", err)
+		return HTMLComponent{content: sanitized}
+	}
+
+	doc.Find("a[href^='http']").Each(func(_ int, link *goquery.Selection) {
+		// Links to the site's own pages aren't paid for
+		href, err := url.Parse(link.AttrOr("href", ""))
+		if err == nil && siteHost != "" && strings.EqualFold(href.Hostname(), siteHost) {
+			return
+		}
+
+		rel := strings.Fields(link.AttrOr("rel", ""))
+		if !slices.Contains(rel, "sponsored") {
+			link.SetAttr("rel", strings.Join(append([]string{"sponsored"}, rel...), " "))
+		}
+	})
+
+	// The parser wraps the fragment in a document, only the body is ours
+	body, err := doc.Find("body").Html()
+	if err != nil {
+		log.Printf("Error rendering HTML: %v", err)
+		return HTMLComponent{content: sanitized}
+	}
+
+	return HTMLComponent{content: body}
+}
+
+func sanitizeArticleHTML(content string) string {
 	p := bluemonday.UGCPolicy()
 
 	p.AllowElements("h1", "h2", "h3", "h4", "h5", "h6", "p", "ul", "ol", "li",
@@ -51,7 +97,7 @@ func ParseHTML(content string) templ.Component {
 		sanitized = strings.ReplaceAll(sanitized, "<iframe", `<iframe width="100%" height="480"`)
 	}
 
-	return HTMLComponent{content: sanitized}
+	return sanitized
 }
 
 // ParseHTMLToText sanitizes HTML and returns its plain text representation.
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSponsoredHTML(t *testing.T) {
+	t.Setenv("BASE_URL", "https://macvapress.rs")
+
+	testCases := []struct {
+		name      string
+		href      string
+		sponsored bool
+	}{
+		{"other site", "https://example.com/", true},
+		{"own site", "https://macvapress.rs/vesti", false},
+		{"own site, other case", "https://MacvaPress.rs/vesti", false},
+		{"subdomain", "https://shop.macvapress.rs/", true},
+		{"relative", "/vesti", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := ParseSponsoredHTML(`<a href="`+tc.href+`">x</a>`).Render(context.Background(), &buf)
+			require.NoError(t, err)
+			require.Equal(t, tc.sponsored, bytes.Contains(buf.Bytes(), []byte(`rel="sponsored`)), buf.String())
+		})
+	}
+}
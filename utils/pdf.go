@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 in points
+const (
+	pdfPageWidth  = 595
+	pdfPageHeight = 842
+	pdfMargin     = 50
+)
+
+// Fonts of a PDF, the standard ones every reader has so nothing is embedded
+const (
+	PDFRegular = "F1"
+	PDFBold    = "F2"
+	// PDFMono lines up the columns of tables
+	PDFMono = "F3"
+)
+
+var pdfFonts = []string{"Helvetica", "Helvetica-Bold", "Courier"}
+
+// pdfWinAnsi are the WinAnsi codes of punctuation common in titles
+var pdfWinAnsi = map[rune]byte{
+	'€': 0x80, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93,
+	'”': 0x94, '–': 0x96, '—': 0x97,
+}
+
+// PDF is a plain text document of A4 pages, written line by line.
+type PDF struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+func NewPDF() *PDF {
+	pdf := &PDF{}
+	pdf.newPage()
+	return pdf
+}
+
+func (pdf *PDF) newPage() {
+	pdf.pages = append(pdf.pages, &bytes.Buffer{})
+	pdf.y = pdfPageHeight - pdfMargin
+}
+
+// Line writes text under the previous line, on a new page when this one is
+// full.
+func (pdf *PDF) Line(font string, size float64, text string) {
+	leading := size * 1.4
+	if pdf.y-leading < pdfMargin {
+		pdf.newPage()
+	}
+	pdf.y -= leading
+
+	fmt.Fprintf(pdf.pages[len(pdf.pages)-1], "BT /%s %.1f Tf %d %.1f Td (%s) Tj ET\n",
+		font, size, pdfMargin, pdf.y, pdfText(text))
+}
+
+// Space leaves height points empty under the previous line.
+func (pdf *PDF) Space(height float64) {
+	pdf.y -= height
+}
+
+// Bytes returns the document.
+func (pdf *PDF) Bytes() []byte {
+	var b bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, b.Len())
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// The catalog, the page tree and the fonts come first, then each page
+	// and its content
+	firstPage := 3 + len(pdfFonts)
+	kids := make([]string, len(pdf.pages))
+	for i := range pdf.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	fonts := make([]string, len(pdfFonts))
+	for i := range pdfFonts {
+		fonts[i] = fmt.Sprintf("/F%d %d 0 R", i+1, 3+i)
+	}
+
+	b.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pdf.pages)))
+	for _, font := range pdfFonts {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font))
+	}
+	for i, page := range pdf.pages {
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, strings.Join(fonts, " "), firstPage+2*i+1,
+		))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", page.Len(), page.String()))
+	}
+
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return b.Bytes()
+}
+
+// pdfText spells text in plain Latin, the standard fonts have no Serbian
+// letters, and escapes it for a PDF string.
+func pdfText(text string) string {
+	var b strings.Builder
+
+	for _, r := range PlainLatin(text) {
+		if code, ok := pdfWinAnsi[r]; ok {
+			b.WriteByte(code)
+			continue
+		}
+
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r >= ' ' && r < 0x7f, r >= 0xa0 && r <= 0xff:
+			// WinAnsi matches Latin-1 here
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlainLatin(t *testing.T) {
+	testCases := []struct {
+		text  string
+		plain string
+	}{
+		{"Šabac", "Sabac"},
+		{"Ђорђе Џаја", "Djordje Dzaja"},
+		{"Љубав и њива", "Ljubav i njiva"},
+		{"Izveštaj za 2025.", "Izvestaj za 2025."},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.plain, PlainLatin(tc.text))
+	}
+}
+
+func TestPDFText(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"plain", "Klikovi: 12", "Klikovi: 12"},
+		{"parentheses", "Reklama (baner)", `Reklama \(baner\)`},
+		{"backslash", `a\b`, `a\\b`},
+		{"serbian", "Čačak", "Cacak"},
+		{"punctuation", "„Vesti“ – 5€", "\x84Vesti\x93 \x96 5\x80"},
+		{"latin-1", "Café", "Caf\xe9"},
+		{"unknown", "emoji 😀 i ☃", "emoji ? i ?"},
+		{"control", "a\tb", "a?b"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, pdfText(tc.text))
+		})
+	}
+}
+
+var (
+	pdfXrefEntry  = regexp.MustCompile(`(\d{10}) 00000 n `)
+	pdfStartXref  = regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`)
+	pdfPageObject = regexp.MustCompile(`/Type /Page `)
+)
+
+// checkPDFXref checks that the cross-reference table points at each object
+// and startxref at the table.
+func checkPDFXref(t *testing.T, data []byte) int {
+	m := pdfStartXref.FindSubmatch(data)
+	require.NotNil(t, m)
+	xref, err := strconv.Atoi(string(m[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data[xref:], []byte("xref\n")))
+
+	entries := pdfXrefEntry.FindAllSubmatch(data[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(data[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+
+	return len(entries)
+}
+
+func TestPDFBytes(t *testing.T) {
+	pdf := NewPDF()
+	pdf.Line(PDFBold, 16, "Izveštaj (mart)")
+	pdf.Space(10)
+	pdf.Line(PDFMono, 10, "Prikazi  Klikovi")
+
+	data := pdf.Bytes()
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+	require.Contains(t, string(data), `(Izvestaj \(mart\)) Tj`)
+
+	// The catalog, the page tree, the fonts and one page with its content
+	objects := checkPDFXref(t, data)
+	require.Equal(t, 2+len(pdfFonts)+2, objects)
+	require.Contains(t, string(data), fmt.Sprintf("/Size %d", objects+1))
+	require.Len(t, pdfPageObject.FindAll(data, -1), 1)
+}
+
+func TestPDFPageBreak(t *testing.T) {
+	pdf := NewPDF()
+	for i := 0; i < 100; i++ {
+		pdf.Line(PDFRegular, 12, fmt.Sprintf("Red %d", i))
+	}
+
+	// A4 less the margins holds 44 lines of 12 points
+	require.Len(t, pdf.pages, 3)
+
+	data := pdf.Bytes()
+	objects := checkPDFXref(t, data)
+	require.Equal(t, 2+len(pdfFonts)+2*3, objects)
+	require.Len(t, pdfPageObject.FindAll(data, -1), 3)
+	require.Contains(t, string(data), "/Count 3")
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"image"
+	"math/bits"
+
+	"github.com/disintegration/imaging"
+)
+
+// DuplicateHashDistance is the largest number of differing hash bits at which
+// two images are still treated as the same photo. Re-encoding, resizing and
+// small crops usually stay well below it, different photos end up around 32.
+const DuplicateHashDistance = 6
+
+// ImageHash opens the image at path and returns its perceptual hash.
+func ImageHash(path string) (int64, error) {
+	img, err := imaging.Open(path, imaging.AutoOrientation(true))
+	if err != nil {
+		return 0, err
+	}
+	return DifferenceHash(img), nil
+}
+
+// DifferenceHash computes a 64 bit dHash: the image is shrunk to 9x8 grayscale
+// pixels and every bit records whether a pixel is brighter than its right
+// neighbour. Similar images produce hashes that differ in only a few bits.
+// The hash is returned as int64 so it can be stored in a BIGINT column.
+func DifferenceHash(img image.Image) int64 {
+	small := imaging.Grayscale(imaging.Resize(img, 9, 8, imaging.Box))
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			left := small.Pix[small.PixOffset(x, y)]
+			right := small.Pix[small.PixOffset(x+1, y)]
+			hash <<= 1
+			if left > right {
+				hash |= 1
+			}
+		}
+	}
+	return int64(hash)
+}
+
+// HashDistance returns the number of bits in which two perceptual hashes differ.
+func HashDistance(a, b int64) int {
+	return bits.OnesCount64(uint64(a ^ b))
+}
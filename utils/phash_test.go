@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/disintegration/imaging"
+	"github.com/stretchr/testify/require"
+)
+
+// smoothImage is a soft pattern of light and dark patches, like a photo
+// shrunk for hashing
+func smoothImage(width, height int) image.Image {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := 128 + 60*math.Sin(float64(x)/17) + 60*math.Cos(float64(y)/23)
+			img.SetGray(x, y, color.Gray{uint8(v)})
+		}
+	}
+	return img
+}
+
+func TestDifferenceHash(t *testing.T) {
+	img := smoothImage(180, 160)
+	hash := DifferenceHash(img)
+
+	testCases := []struct {
+		name      string
+		img       image.Image
+		duplicate bool
+	}{
+		{"same image", img, true},
+		{"resized", imaging.Resize(img, 90, 80, imaging.Lanczos), true},
+		{"slightly blurred", imaging.Blur(img, 1), true},
+		{"mirrored", imaging.FlipH(img), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			distance := HashDistance(hash, DifferenceHash(tc.img))
+			require.Equal(t, tc.duplicate, distance <= DuplicateHashDistance, "distance %d", distance)
+		})
+	}
+}
+
+func TestHashDistance(t *testing.T) {
+	testCases := []struct {
+		a, b     int64
+		distance int
+	}{
+		{0, 0, 0},
+		{0, 1, 1},
+		{0b1010, 0b0101, 4},
+		{-1, 0, 64},
+		{-1, -1, 0},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.distance, HashDistance(tc.a, tc.b))
+		require.Equal(t, tc.distance, HashDistance(tc.b, tc.a))
+	}
+}
+
+func TestImageHashInvalid(t *testing.T) {
+	_, err := ImageHash(writeTestFile(t, "photo.jpg", []byte("not an image")))
+	require.Error(t, err)
+
+	_, err = ImageHash("missing.jpg")
+	require.Error(t, err)
+}
@@ -78,8 +78,8 @@ func RandomCategoryList(n int) []string {
 	return shuffled[:n]
 }
 
-// RandomPlacement returns a random ad placement between "footer", "side", and "top"
+// RandomPlacement returns a random ad placement between "header", "sidebar-1", "footer" and "article"
 func RandomPlacement() string {
-	placements := []string{"footer", "side", "top"}
+	placements := []string{"header", "sidebar-1", "footer", "article"}
 	return placements[rand.Intn(len(placements))]
 }
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	key := []byte("12345678901234567890123456789012")
+	sig := sign(key, "ad", "123")
+
+	testCases := []struct {
+		name  string
+		key   []byte
+		sig   string
+		parts []string
+		valid bool
+	}{
+		{"same parts", key, sig, []string{"ad", "123"}, true},
+		{"other parts", key, sig, []string{"ad", "124"}, false},
+		{"other key", []byte("other"), sig, []string{"ad", "123"}, false},
+		{"no key", nil, sign(nil, "ad", "123"), []string{"ad", "123"}, false},
+		{"truncated signature", key, sig[:10], []string{"ad", "123"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.valid, verify(tc.key, tc.sig, tc.parts...))
+		})
+	}
+}
+
+// Signed image URLs are cached by browsers and CDNs, the signature mustn't
+// change
+func TestSignImagePath(t *testing.T) {
+	t.Setenv("IMAGE_SIGNING_KEY", "12345678901234567890123456789012")
+
+	mac := hmac.New(sha256.New, []byte("12345678901234567890123456789012"))
+	mac.Write([]byte("320x240/fill-30-70/uploads/abc.webp"))
+	expected := base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:16])
+
+	require.Equal(t, expected, SignImagePath(320, 240, "fill-30-70", "uploads/abc.webp"))
+	require.True(t, VerifyImageSignature(expected, 320, 240, "fill-30-70", "uploads/abc.webp"))
+	require.False(t, VerifyImageSignature(expected, 640, 240, "fill-30-70", "uploads/abc.webp"))
+}
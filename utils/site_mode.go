@@ -0,0 +1,35 @@
+package utils
+
+import "context"
+
+// Modes the site can be switched to from the admin settings
+const (
+	SiteModeNormal = "normal"
+	// SiteModeReadOnly serves pages but turns down logins and every write
+	SiteModeReadOnly = "read_only"
+	// SiteModeMaintenance serves a static page to everyone but admins
+	SiteModeMaintenance = "maintenance"
+)
+
+// SiteMode is the mode the site is in and the message readers are shown.
+type SiteMode struct {
+	Mode    string `json:"mode"`
+	Message string `json:"message"`
+}
+
+type siteModeKey struct{}
+
+// WithSiteMode records the site's mode in the request's context, so pages
+// can show a banner.
+func WithSiteMode(ctx context.Context, mode SiteMode) context.Context {
+	return context.WithValue(ctx, siteModeKey{}, mode)
+}
+
+// SiteModeFromContext returns the mode recorded by WithSiteMode, normal if
+// there is none.
+func SiteModeFromContext(ctx context.Context) SiteMode {
+	if mode, ok := ctx.Value(siteModeKey{}).(SiteMode); ok {
+		return mode
+	}
+	return SiteMode{Mode: SiteModeNormal}
+}
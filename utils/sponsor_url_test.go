@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSponsorReportPath(t *testing.T) {
+	t.Setenv("SPONSOR_REPORT_KEY", "12345678901234567890123456789012")
+	t.Setenv("AD_SIGNING_KEY", "abcdefghijabcdefghijabcdefghijab")
+
+	expires := time.Now().Add(SponsorReportTTL).Truncate(time.Second)
+
+	path := SponsorReportPath("abc", expires)
+	require.True(t, strings.HasPrefix(path, "/sponzorisano/abc?"))
+
+	parsed, err := url.Parse(path)
+	require.NoError(t, err)
+	require.Equal(t, strconv.FormatInt(expires.Unix(), 10), parsed.Query().Get("exp"))
+
+	sig := parsed.Query().Get("sig")
+	require.True(t, VerifySponsorReportSignature(sig, "abc", expires))
+
+	// The expiry is signed, a sponsor can't push it back
+	require.False(t, VerifySponsorReportSignature(sig, "abc", expires.Add(24*time.Hour)))
+	require.False(t, VerifySponsorReportSignature(sig, "abd", expires))
+
+	// Ad links are signed with their own key
+	require.NotEqual(t, sign(adSigningKey(), "sponsor", "abc", parsed.Query().Get("exp")), sig)
+}
+
+func TestSponsorReportExpired(t *testing.T) {
+	t.Setenv("SPONSOR_REPORT_KEY", "12345678901234567890123456789012")
+
+	expired := time.Now().Add(-time.Minute)
+	require.False(t, VerifySponsorReportSignature(SignSponsorReport("abc", expired), "abc", expired))
+
+	// Without a key there is no link and nothing verifies
+	t.Setenv("SPONSOR_REPORT_KEY", "")
+	expires := time.Now().Add(time.Hour)
+	require.Equal(t, "", SponsorReportPath("abc", expires))
+	require.False(t, VerifySponsorReportSignature(SignSponsorReport("abc", expires), "abc", expires))
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/disintegration/imaging"
+)
+
+// Watermark positions, stored in global_settings.watermark_position
+const (
+	WatermarkTopLeft     = "top-left"
+	WatermarkTopRight    = "top-right"
+	WatermarkBottomLeft  = "bottom-left"
+	WatermarkBottomRight = "bottom-right"
+	WatermarkCenter      = "center"
+)
+
+// WatermarkPositions lists the positions in the order the admin settings offer them
+var WatermarkPositions = []string{WatermarkTopLeft, WatermarkTopRight, WatermarkBottomLeft, WatermarkBottomRight, WatermarkCenter}
+
+// WatermarkLogoDir holds the logos that can be used as a watermark
+const WatermarkLogoDir = "static/assets"
+
+// Watermark describes how the logo is stamped onto editorial photos.
+type Watermark struct {
+	Logo     string  // URL of a PNG in WatermarkLogoDir, e.g. /static/assets/logo.png
+	Position string  // one of the Watermark* positions
+	Opacity  float64 // 0 is invisible, 1 is fully opaque
+	Scale    float64 // logo width as a fraction of the photo width
+}
+
+// WatermarkLogos returns the URLs of the PNG logos available in WatermarkLogoDir.
+func WatermarkLogos() []string {
+	files, err := os.ReadDir(WatermarkLogoDir)
+	if err != nil {
+		return nil
+	}
+
+	var logos []string
+	for _, f := range files {
+		if f.IsDir() || strings.ToLower(filepath.Ext(f.Name())) != ".png" {
+			continue
+		}
+		logos = append(logos, "/"+WatermarkLogoDir+"/"+f.Name())
+	}
+	sort.Strings(logos)
+	return logos
+}
+
+// ApplyWatermark draws the logo onto img. The logo is scaled relative to the
+// photo so it looks the same on every size and kept a small margin from the edges.
+func ApplyWatermark(img image.Image, wm Watermark) (image.Image, error) {
+	logoPath := strings.TrimPrefix(wm.Logo, "/")
+	if filepath.Dir(logoPath) != WatermarkLogoDir {
+		return nil, fmt.Errorf("watermark logo must be in %s: %q", WatermarkLogoDir, wm.Logo)
+	}
+
+	logo, err := imaging.Open(logoPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening watermark logo: %v", err)
+	}
+
+	bounds := img.Bounds()
+	logoWidth := int(float64(bounds.Dx()) * wm.Scale)
+	if logoWidth < 1 {
+		return img, nil
+	}
+	logo = imaging.Resize(logo, logoWidth, 0, imaging.Lanczos)
+
+	margin := bounds.Dx() / 50
+	logoBounds := logo.Bounds()
+	left, top := bounds.Min.X+margin, bounds.Min.Y+margin
+	right := bounds.Max.X - logoBounds.Dx() - margin
+	bottom := bounds.Max.Y - logoBounds.Dy() - margin
+
+	var pos image.Point
+	switch wm.Position {
+	case WatermarkTopLeft:
+		pos = image.Pt(left, top)
+	case WatermarkTopRight:
+		pos = image.Pt(right, top)
+	case WatermarkBottomLeft:
+		pos = image.Pt(left, bottom)
+	case WatermarkCenter:
+		pos = image.Pt(bounds.Min.X+(bounds.Dx()-logoBounds.Dx())/2, bounds.Min.Y+(bounds.Dy()-logoBounds.Dy())/2)
+	default:
+		pos = image.Pt(right, bottom)
+	}
+
+	return imaging.Overlay(img, logo, pos, wm.Opacity), nil
+}